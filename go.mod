@@ -2,4 +2,4 @@ module github.com/tmdgusya/go-data-structure
 
 go 1.25.3
 
-require golang.org/x/exp v0.0.0-20251023183803-a4bb9ffd2546 // indirect
+require golang.org/x/exp v0.0.0-20251023183803-a4bb9ffd2546
@@ -1,6 +1,9 @@
 package grid
 
-import "math"
+import (
+	"iter"
+	"math"
+)
 
 const threshold = 1e-9
 
@@ -158,3 +161,33 @@ func (g *Grid) LinearScan(x float64, y float64) *GridPoint {
 	}
 	return best_candidate
 }
+
+// (xbin, ybin) 칸에 들어있는 점들의 좌표를 순회한다. 범위 밖의 칸이면 아무것도 yield 하지 않는다.
+func (g *Grid) Bin(xbin int, ybin int) iter.Seq2[float64, float64] {
+	return func(yield func(float64, float64) bool) {
+		if xbin < 0 || xbin >= g.num_x_bins || ybin < 0 || ybin >= g.num_y_bins {
+			return
+		}
+
+		for current := g.bins[xbin][ybin]; current != nil; current = current.next {
+			if !yield(current.x, current.y) {
+				return
+			}
+		}
+	}
+}
+
+// 모든 점의 좌표를 칸 단위로 (xbin, ybin 오름차순) 순회한다.
+func (g *Grid) All() iter.Seq2[float64, float64] {
+	return func(yield func(float64, float64) bool) {
+		for xbin := 0; xbin < g.num_x_bins; xbin++ {
+			for ybin := 0; ybin < g.num_y_bins; ybin++ {
+				for x, y := range g.Bin(xbin, ybin) {
+					if !yield(x, y) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
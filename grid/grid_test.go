@@ -1092,3 +1092,57 @@ func TestLinearScanWithManyPoints(t *testing.T) {
 			closestPoint.x, closestPoint.y, result.x, result.y)
 	}
 }
+
+func TestIterators(t *testing.T) {
+	g := Grid{
+		num_x_bins:  3,
+		num_y_bins:  3,
+		x_start:     0.0,
+		x_end:       30.0,
+		y_start:     0.0,
+		y_end:       30.0,
+		x_bin_width: 10.0,
+		y_bin_width: 10.0,
+		bins:        make([][]*GridPoint, 3),
+	}
+
+	// Initialize bins
+	for i := range g.bins {
+		g.bins[i] = make([]*GridPoint, 3)
+	}
+
+	g.Insert(25.0, 5.0)
+	g.Insert(5.0, 15.0)
+	g.Insert(5.0, 5.0)
+	g.Insert(6.0, 6.0)
+
+	type point struct{ x, y float64 }
+
+	got := []point{}
+	for x, y := range g.All() {
+		got = append(got, point{x, y})
+	}
+
+	// Bins are visited in (xbin, ybin) order, newest point first within a bin
+	expected := []point{{6.0, 6.0}, {5.0, 5.0}, {5.0, 15.0}, {25.0, 5.0}}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d points, got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Point %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+
+	count := 0
+	for range g.Bin(0, 0) {
+		count++
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 points in bin (0, 0), got %d", count)
+	}
+
+	for range g.Bin(-1, 5) {
+		t.Error("Expected no points for out of range bin")
+	}
+}
@@ -0,0 +1,256 @@
+package heap
+
+// BinomialHandle 은 BinomialHeap 에 넣은 원소를 가리킨다.
+// DecreaseKey 가 값을 트리 위쪽 노드와 맞바꿔도 핸들은 계속 같은 원소를 가리킨다.
+type BinomialHandle[T any] struct {
+	value T
+	node  *binomialNode[T]
+	owner *owner
+}
+
+func (e *BinomialHandle[T]) Value() T {
+	return e.value
+}
+
+type binomialNode[T any] struct {
+	item    *BinomialHandle[T]
+	parent  *binomialNode[T]
+	child   *binomialNode[T] // 차수가 가장 큰 자식
+	sibling *binomialNode[T]
+	degree  int
+}
+
+// BinomialHeap 은 차수 순으로 정렬된 binomial tree 들의 루트 리스트로 이루어진 mergeable 힙이다.
+// Insert 는 amortized O(1), Meld, Peek, Remove, DecreaseKey 는 O(log n) 이다.
+// less(a, b) 가 true 이면 a 가 b 보다 먼저 나온다.
+type BinomialHeap[T any] struct {
+	head  *binomialNode[T]
+	size  int
+	owner *owner
+	less  func(a T, b T) bool
+}
+
+// GetPriority 가 가장 큰 값이 먼저 나오는 binomial heap 을 만든다.
+func NewBinomialHeap[T Prioritized]() *BinomialHeap[T] {
+	return NewBinomialHeapFunc(MaxPriority[T])
+}
+
+func NewBinomialHeapFunc[T any](less func(a T, b T) bool) *BinomialHeap[T] {
+	return &BinomialHeap[T]{
+		owner: &owner{},
+		less:  less,
+	}
+}
+
+func (h *BinomialHeap[T]) Size() int {
+	return h.size
+}
+
+// 같은 차수의 두 트리 중 child 를 parent 의 첫 자식으로 붙인다.
+func (h *BinomialHeap[T]) link(child *binomialNode[T], parent *binomialNode[T]) {
+	child.parent = parent
+	child.sibling = parent.child
+	parent.child = child
+	parent.degree++
+}
+
+// 차수 오름차순인 두 루트 리스트를 하나로 합친다. 같은 차수의 트리는 아직 합치지 않는다.
+func mergeRoots[T any](a *binomialNode[T], b *binomialNode[T]) *binomialNode[T] {
+	var head binomialNode[T]
+	tail := &head
+
+	for a != nil && b != nil {
+		if a.degree <= b.degree {
+			tail.sibling = a
+			a = a.sibling
+		} else {
+			tail.sibling = b
+			b = b.sibling
+		}
+		tail = tail.sibling
+	}
+
+	if a != nil {
+		tail.sibling = a
+	} else {
+		tail.sibling = b
+	}
+	return head.sibling
+}
+
+// roots 를 루트 리스트에 합치고 같은 차수의 트리가 하나만 남도록 정리한다.
+func (h *BinomialHeap[T]) union(roots *binomialNode[T]) {
+	head := mergeRoots(h.head, roots)
+	if head == nil {
+		h.head = nil
+		return
+	}
+
+	var prev *binomialNode[T]
+	curr := head
+	next := curr.sibling
+	for next != nil {
+		if curr.degree != next.degree || (next.sibling != nil && next.sibling.degree == curr.degree) {
+			// 차수가 다르거나, 같은 차수가 세 개 연속이면 뒤의 두 개를 먼저 합치도록 넘어간다
+			prev = curr
+			curr = next
+		} else if !h.less(next.item.value, curr.item.value) {
+			curr.sibling = next.sibling
+			h.link(next, curr)
+		} else {
+			if prev == nil {
+				head = next
+			} else {
+				prev.sibling = next
+			}
+			h.link(curr, next)
+			curr = next
+		}
+		next = curr.sibling
+	}
+
+	h.head = head
+}
+
+// 삽입한 원소의 핸들을 반환한다. 핸들로 나중에 DecreaseKey 할 수 있다.
+func (h *BinomialHeap[T]) Insert(value T) *BinomialHandle[T] {
+	item := &BinomialHandle[T]{value: value, owner: h.owner}
+	node := &binomialNode[T]{item: item}
+	item.node = node
+
+	// 이진 카운터에 1 을 더하듯이 앞쪽의 같은 차수 트리와 합치다가 빈 차수를 만나면 멈춘다.
+	// 루트 리스트 전체를 도는 union 과 달리 amortized O(1) 이다.
+	for h.head != nil && h.head.degree == node.degree {
+		root := h.head
+		h.head = root.sibling
+		root.sibling = nil
+
+		if h.less(node.item.value, root.item.value) {
+			h.link(root, node)
+		} else {
+			h.link(node, root)
+			node = root
+		}
+	}
+	node.sibling = h.head
+	h.head = node
+
+	h.size++
+	return item
+}
+
+func (h *BinomialHeap[T]) Push(value T) {
+	h.Insert(value)
+}
+
+// 루트 리스트에서 가장 앞서는 루트와 그 앞 루트를 찾는다.
+func (h *BinomialHeap[T]) first() (*binomialNode[T], *binomialNode[T]) {
+	var best, best_prev, prev *binomialNode[T]
+	for curr := h.head; curr != nil; curr = curr.sibling {
+		if best == nil || h.less(curr.item.value, best.item.value) {
+			best = curr
+			best_prev = prev
+		}
+		prev = curr
+	}
+	return best, best_prev
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다.
+func (h *BinomialHeap[T]) Peek() T {
+	value, _ := h.TryPeek()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *BinomialHeap[T]) TryPeek() (T, error) {
+	best, _ := h.first()
+	if best == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return best.item.value, nil
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryRemove 를 사용한다.
+func (h *BinomialHeap[T]) Remove() T {
+	value, _ := h.TryRemove()
+	return value
+}
+
+// less 기준으로 가장 앞서는 원소를 꺼낸다. 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *BinomialHeap[T]) TryRemove() (T, error) {
+	best, best_prev := h.first()
+	if best == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	// 루트 리스트에서 떼어냄
+	if best_prev == nil {
+		h.head = best.sibling
+	} else {
+		best_prev.sibling = best.sibling
+	}
+
+	// 자식들은 차수 내림차순이므로 뒤집어서 새 루트 리스트로 만든다
+	var children *binomialNode[T]
+	for child := best.child; child != nil; {
+		next := child.sibling
+		child.parent = nil
+		child.sibling = children
+		children = child
+		child = next
+	}
+
+	h.union(children)
+	h.size--
+
+	item := best.item
+	item.node = nil
+	item.owner = nil
+	return item.value, nil
+}
+
+// handle 이 이 힙에 남아있는 원소를 가리키는지 확인한다.
+func (h *BinomialHeap[T]) Contains(handle *BinomialHandle[T]) bool {
+	return handle != nil && handle.owner != nil && handle.owner.find() == h.owner
+}
+
+// handle 의 값을 less 기준으로 더 앞서거나 같은 value 로 바꾼다.
+// value 가 기존 값보다 뒤로 가는 값이거나 handle 이 이 힙의 원소가 아니면 false 를 반환한다.
+func (h *BinomialHeap[T]) DecreaseKey(handle *BinomialHandle[T], value T) bool {
+	if !h.Contains(handle) || h.less(handle.value, value) {
+		return false
+	}
+
+	handle.value = value
+
+	// 부모보다 앞서는 동안 부모 노드와 원소를 맞바꾼다
+	node := handle.node
+	for node.parent != nil && h.less(node.item.value, node.parent.item.value) {
+		parent := node.parent
+		node.item, parent.item = parent.item, node.item
+		node.item.node = node
+		parent.item.node = parent
+		node = parent
+	}
+
+	return true
+}
+
+// other 의 모든 원소를 이 힙으로 옮기고 other 는 비운다.
+// other 의 핸들은 이 힙에서 계속 사용할 수 있다.
+func (h *BinomialHeap[T]) Meld(other *BinomialHeap[T]) {
+	if other == nil || other == h || other.head == nil {
+		return
+	}
+
+	h.union(other.head)
+	h.size += other.size
+
+	other.owner.parent = h.owner
+	other.owner = &owner{}
+	other.head = nil
+	other.size = 0
+}
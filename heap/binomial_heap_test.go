@@ -0,0 +1,128 @@
+package heap_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	heap "github.com/tmdgusya/go-data-structure/heap"
+)
+
+func TestBinomialHeapMaxPriority(t *testing.T) {
+	h := heap.NewBinomialHeap[Job]()
+	h.Insert(Job{Priority: 1, JobID: "JOB-001"})
+	h.Insert(Job{Priority: 5, JobID: "JOB-002"})
+	h.Insert(Job{Priority: 3, JobID: "JOB-003"})
+
+	for _, exp := range []string{"JOB-002", "JOB-003", "JOB-001"} {
+		if job := h.Remove(); job.JobID != exp {
+			t.Errorf("Remove 기대값: %s, 실제값: %s", exp, job.JobID)
+		}
+	}
+}
+
+func TestBinomialHeapDecreaseKey(t *testing.T) {
+	h := heap.NewBinomialHeapFunc(intLess)
+
+	handles := []*heap.BinomialHandle[int]{}
+	for i := 1; i <= 16; i++ {
+		handles = append(handles, h.Insert(i*10))
+	}
+
+	// 가장 깊은 곳에 있을 값을 루트보다 앞서게 만듦
+	if !h.DecreaseKey(handles[15], 1) {
+		t.Error("DecreaseKey 가 실패했습니다")
+	}
+	if h.Peek() != 1 || handles[15].Value() != 1 {
+		t.Errorf("DecreaseKey 후 Peek 기대값: 1, 실제값: %d", h.Peek())
+	}
+
+	if h.DecreaseKey(handles[0], 20) {
+		t.Error("값을 늘리는 DecreaseKey 가 성공했습니다")
+	}
+
+	// 원소가 노드 사이를 옮겨다녀도 핸들은 같은 원소를 가리켜야 함
+	if !h.DecreaseKey(handles[7], 2) || handles[7].Value() != 2 {
+		t.Error("두 번째 DecreaseKey 가 올바르게 동작하지 않았습니다")
+	}
+
+	if h.Remove() != 1 || h.Contains(handles[15]) {
+		t.Error("Remove 후 핸들이 무효가 되지 않았습니다")
+	}
+	if h.DecreaseKey(handles[15], 0) {
+		t.Error("제거된 핸들로 DecreaseKey 가 성공했습니다")
+	}
+	if h.Remove() != 2 || h.Remove() != 10 {
+		t.Error("DecreaseKey 후 Remove 순서가 올바르지 않습니다")
+	}
+}
+
+func TestBinomialHeapRandomDecreaseKey(t *testing.T) {
+	h := heap.NewBinomialHeapFunc(intLess)
+	r := rand.New(rand.NewSource(5))
+
+	handles := []*heap.BinomialHandle[int]{}
+	for i := 0; i < 500; i++ {
+		handles = append(handles, h.Insert(r.Intn(100000)))
+	}
+	for i := 0; i < 100; i++ {
+		h.Remove()
+	}
+
+	expected := []int{}
+	for _, handle := range handles {
+		if !h.Contains(handle) {
+			continue
+		}
+		v := handle.Value() - r.Intn(50000)
+		if !h.DecreaseKey(handle, v) {
+			t.Fatal("DecreaseKey 가 실패했습니다")
+		}
+		expected = append(expected, v)
+	}
+
+	slices.Sort(expected)
+	for _, exp := range expected {
+		if v := h.Remove(); v != exp {
+			t.Fatalf("Remove 기대값: %d, 실제값: %d", exp, v)
+		}
+	}
+}
+
+func TestBinomialHeapMeld(t *testing.T) {
+	a := heap.NewBinomialHeapFunc(intLess)
+	b := heap.NewBinomialHeapFunc(intLess)
+
+	expected := []int{}
+	for i := 0; i < 13; i++ {
+		a.Push(i * 3)
+		expected = append(expected, i*3)
+	}
+	var handle *heap.BinomialHandle[int]
+	for i := 0; i < 9; i++ {
+		handle = b.Insert(i*5 + 1)
+	}
+	for i := 0; i < 8; i++ {
+		expected = append(expected, i*5+1)
+	}
+	expected = append(expected, -1)
+
+	a.Meld(b)
+
+	if a.Size() != 22 || b.Size() != 0 {
+		t.Errorf("Meld 후 크기가 올바르지 않습니다. a: %d, b: %d", a.Size(), b.Size())
+	}
+	if !a.Contains(handle) || b.Contains(handle) {
+		t.Error("Meld 후 핸들의 소속이 옮겨지지 않았습니다")
+	}
+	if !a.DecreaseKey(handle, -1) {
+		t.Error("Meld 후 옮겨진 핸들로 DecreaseKey 가 실패했습니다")
+	}
+
+	slices.Sort(expected)
+	for _, exp := range expected {
+		if v := a.Remove(); v != exp {
+			t.Fatalf("Meld 후 Remove 기대값: %d, 실제값: %d", exp, v)
+		}
+	}
+}
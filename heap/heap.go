@@ -1,20 +1,133 @@
 package heap
 
+import (
+	"errors"
+	"iter"
+)
+
+// 빈 힙에서 값을 꺼내거나 조회하려고 할 때 반환된다.
+var ErrEmpty = errors.New("heap: empty heap")
+
+// Interface 는 배열 기반 Heap 과 포인터 기반 PairingHeap, BinomialHeap 이 공통으로 제공하는 동작이다.
+// 어느 구현이든 Remove 는 less 기준으로 가장 앞서는 원소(extract-min)를 꺼낸다.
+type Interface[T any] interface {
+	Push(value T)
+	Peek() T
+	TryPeek() (T, error)
+	Remove() T
+	TryRemove() (T, error)
+	Size() int
+}
+
+// AddressableHeap 은 Insert 가 돌려준 핸들로 원소의 값을 앞당기고(DecreaseKey), 같은 종류의 힙을 합칠(Meld) 수 있는 힙이다.
+// H 는 구현마다 다른 핸들 타입이고 S 는 Meld 로 합칠 수 있는 힙 자신의 타입이다.
+// 예를 들어 func run[H any, S AddressableHeap[int, H, S]](h S) 처럼 쓰면 세 구현을 바꿔 끼울 수 있다.
+type AddressableHeap[T any, H any, S any] interface {
+	Interface[T]
+	Insert(value T) H
+	Contains(handle H) bool
+	DecreaseKey(handle H, value T) bool
+	Meld(other S)
+}
+
+var (
+	_ Interface[int] = (*Heap[int])(nil)
+	_ Interface[int] = (*PairingHeap[int])(nil)
+	_ Interface[int] = (*BinomialHeap[int])(nil)
+
+	_ AddressableHeap[int, *Handle[int], *Heap[int]]                 = (*Heap[int])(nil)
+	_ AddressableHeap[int, *PairingNode[int], *PairingHeap[int]]     = (*PairingHeap[int])(nil)
+	_ AddressableHeap[int, *BinomialHandle[int], *BinomialHeap[int]] = (*BinomialHeap[int])(nil)
+)
+
 type Prioritized interface {
 	GetPriority() int
 }
 
-type Heap[T Prioritized] struct {
-	array      []*T
+// GetPriority 가 큰 값이 먼저 나오는 순서. NewHeap 이 사용하는 기본 순서이다.
+func MaxPriority[T Prioritized](a T, b T) bool {
+	return a.GetPriority() > b.GetPriority()
+}
+
+// GetPriority 가 작은 값이 먼저 나오는 순서
+func MinPriority[T Prioritized](a T, b T) bool {
+	return a.GetPriority() < b.GetPriority()
+}
+
+// Heap 은 less 로 순서를 정하는 배열 기반 d-ary 힙이다. 기본은 이진 힙(arity 2)이다.
+// less(a, b) 가 true 이면 a 가 b 보다 먼저 나온다. 즉 루트에는 less 기준으로 가장 앞서는 값이 있다.
+type Heap[T any] struct {
+	array      []T
+	handles    []*Handle[T] // array 와 같은 위치에 있는 원소의 핸들. 첫 Insert 전까지는 nil 이다
 	array_size int
 	last_index int
+	min_size   int // 생성 시 지정한 크기. 자동으로 줄어들 때의 하한(minShrinkSize 이상)
+	arity      int // 노드 하나가 가지는 자식 수
+	less       func(a T, b T) bool
+}
+
+// Handle 은 Insert 한 원소를 가리킨다. 원소가 sift 로 자리를 옮겨도 계속 같은 원소를 가리키며,
+// 원소가 힙에서 빠지면 더 이상 유효하지 않다.
+// Insert 는 호출마다 Handle 하나를 할당한다. 핸들이 필요 없으면 Push 를 쓴다.
+// Push 만 쓰는 힙은 handles 배열을 만들지도 갱신하지도 않으므로 배열이 늘어날 때 말고는 할당이 없다.
+type Handle[T any] struct {
+	index int
+	heap  *Heap[T]
 }
 
+// GetPriority 가 가장 큰 값이 먼저 나오는 max heap 을 만든다.
 func NewHeap[T Prioritized](size int) *Heap[T] {
+	return NewHeapFunc(size, MaxPriority[T])
+}
+
+// less 로 순서를 정하는 힙을 만든다. min heap, max heap, 여러 키를 비교하는 순서 모두 less 로 표현한다.
+// size 가 0 이하이면 빈 배열로 시작해서 첫 Insert 때 늘어난다.
+func NewHeapFunc[T any](size int, less func(a T, b T) bool) *Heap[T] {
+	return NewDaryHeapFunc(2, size, less)
+}
+
+// 자식을 arity 개씩 가지는 max heap 을 만든다.
+func NewDaryHeap[T Prioritized](arity int, size int) *Heap[T] {
+	return NewDaryHeapFunc(arity, size, MaxPriority[T])
+}
+
+// 자식을 arity 개씩 가지는 힙을 만든다. arity 가 2 보다 작으면 이진 힙이 된다.
+// arity 가 크면 트리가 낮아져 moveUp(Insert, decrease-key) 이 빨라지는 대신
+// moveDown(Remove) 에서 비교할 자식이 늘어난다.
+func NewDaryHeapFunc[T any](arity int, size int, less func(a T, b T) bool) *Heap[T] {
+	size = max(size, 0)
+
 	return &Heap[T]{
-		array:      make([]*T, size),
+		array:      make([]T, size),
 		array_size: size,
 		last_index: 0,
+		min_size:   size,
+		arity:      max(arity, 2),
+		less:       less,
+	}
+}
+
+// items 로 힙을 만든다. N 번 Insert 하는 O(n log n) 대신 bottom-up heapify 로 O(n) 에 만든다.
+// items 로 넣은 원소에는 핸들이 없다.
+func NewHeapFrom[T Prioritized](items []T) *Heap[T] {
+	return NewHeapFromFunc(items, MaxPriority[T])
+}
+
+func NewHeapFromFunc[T any](items []T, less func(a T, b T) bool) *Heap[T] {
+	h := NewHeapFunc(len(items), less)
+	// items 의 개수는 용량 힌트가 아니므로 원소가 빠지면 그만큼 줄어들 수 있게 한다
+	h.min_size = 0
+	copy(h.array, items)
+	h.last_index = len(items)
+
+	h.heapify()
+	return h
+}
+
+// 자식이 있는 마지막 노드부터 루트까지 거꾸로 moveDown 한다. (Floyd)
+func (h *Heap[T]) heapify() {
+	for i := (h.last_index - 2) / h.arity; i >= 0; i-- {
+		h.moveDown(i)
 	}
 }
 
@@ -22,63 +135,200 @@ func (h *Heap[T]) Size() int {
 	return h.last_index
 }
 
+// 빈 힙이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryPeek 을 사용한다.
 func (h *Heap[T]) Peek() T {
-	if h.last_index > 0 {
-		return *h.array[0]
+	value, _ := h.TryPeek()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *Heap[T]) TryPeek() (T, error) {
+	if h.last_index == 0 {
+		var zero T
+		return zero, ErrEmpty
 	}
-	var zero T
-	return zero
+	return h.array[0], nil
+}
+
+// 내부 배열의 크기
+func (h *Heap[T]) Cap() int {
+	return h.array_size
 }
 
 func (h *Heap[T]) Resize() {
-	new_arr := make([]*T, h.array_size*2)
-	copy(new_arr, h.array)
+	// 크기가 0 이면 두 배를 해도 0 이므로 1 부터 시작한다
+	h.resizeTo(max(h.array_size*2, 1))
+}
+
+func (h *Heap[T]) resizeTo(size int) {
+	new_arr := make([]T, size)
+	copy(new_arr, h.array[:h.last_index])
 	h.array = new_arr
-	h.array_size = h.array_size * 2
+
+	if h.handles != nil {
+		new_handles := make([]*Handle[T], size)
+		copy(new_handles, h.handles[:h.last_index])
+		h.handles = new_handles
+	}
+
+	h.array_size = size
+}
+
+// 내부 배열을 원소 개수에 딱 맞게 줄인다.
+func (h *Heap[T]) Shrink() {
+	if h.array_size > h.last_index {
+		h.resizeTo(h.last_index)
+	}
+}
+
+// 자동으로 줄어들 때 이 크기 밑으로는 내려가지 않는다.
+// 0 까지 줄어들면 빈 힙에 Push/Remove 를 반복할 때마다 배열을 새로 할당하게 된다.
+const minShrinkSize = 8
+
+// 원소 수가 용량의 1/4 이하로 줄면 max(생성 시 크기, minShrinkSize) 밑으로는 내려가지 않는 선에서 절반으로 줄인다.
+// 그보다 더 줄이려면 Shrink 를 호출한다.
+func (h *Heap[T]) shrinkIfSparse() {
+	half := h.array_size / 2
+	if half < max(h.min_size, minShrinkSize) || h.last_index > h.array_size/4 {
+		return
+	}
+
+	h.resizeTo(half)
+}
+
+// 모든 원소를 버리고 생성 시 크기로 되돌린다. 남아있던 핸들은 모두 무효가 된다.
+func (h *Heap[T]) Clear() {
+	for _, handle := range h.handles[:min(len(h.handles), h.last_index)] {
+		if handle != nil {
+			handle.index = -1
+			handle.heap = nil
+		}
+	}
+
+	h.array = make([]T, h.min_size)
+	h.handles = nil
+	h.array_size = h.min_size
+	h.last_index = 0
+}
+
+// 삽입한 원소의 핸들을 반환한다. 핸들로 나중에 원소를 갱신하거나 제거할 수 있다.
+func (h *Heap[T]) Insert(value T) *Handle[T] {
+	handle := &Handle[T]{heap: h}
+	h.push(value, handle)
+	return handle
+}
+
+// 핸들 없이 삽입한다. 핸들이 필요 없으면 Insert 대신 사용해서 핸들 할당을 피할 수 있다.
+// 배열이 늘어날 때 말고는 할당하지 않는다.
+func (h *Heap[T]) Push(value T) {
+	h.push(value, nil)
 }
 
-func (h *Heap[T]) Insert(value T) {
+// handle 은 nil 일 수 있다.
+func (h *Heap[T]) push(value T, handle *Handle[T]) {
 	if h.last_index == h.array_size {
 		h.Resize()
 	}
 
-	h.array[h.last_index] = &value
+	h.array[h.last_index] = value
+	if handle != nil {
+		h.trackHandles()
+		handle.index = h.last_index
+	}
+	if h.handles != nil {
+		h.handles[h.last_index] = handle
+	}
 	curr := h.last_index
 	h.last_index++ // 다음 삽입 위치로 이동
 
 	h.moveUp(curr)
 }
 
+// 핸들을 처음 쓸 때 handles 배열을 만든다. 이전에 들어온 원소의 자리는 nil 이다.
+func (h *Heap[T]) trackHandles() {
+	if h.handles == nil {
+		h.handles = make([]*Handle[T], h.array_size)
+	}
+}
+
+// 두 위치의 원소를 바꾸고 핸들이 가리키는 위치도 함께 갱신한다.
+// 핸들 없이 들어온 원소는 handles 자리가 nil 이다.
+func (h *Heap[T]) swap(i int, j int) {
+	h.array[i], h.array[j] = h.array[j], h.array[i]
+	if h.handles == nil {
+		return
+	}
+
+	h.handles[i], h.handles[j] = h.handles[j], h.handles[i]
+	if h.handles[i] != nil {
+		h.handles[i].index = i
+	}
+	if h.handles[j] != nil {
+		h.handles[j].index = j
+	}
+}
+
 func (h *Heap[T]) moveUp(curr int) {
-	parent := (curr - 1) / 2
-	for curr > 0 && (*h.array[parent]).GetPriority() < (*h.array[curr]).GetPriority() {
-		temp := h.array[parent] // 주소
-		h.array[parent] = h.array[curr]
-		h.array[curr] = temp
+	parent := (curr - 1) / h.arity
+	for curr > 0 && h.less(h.array[curr], h.array[parent]) {
+		h.swap(parent, curr)
 
 		// 위치 업데이트
 		curr = parent
-		parent = (curr - 1) / 2
+		parent = (curr - 1) / h.arity
 	}
 }
 
+// 빈 힙이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryRemove 를 사용한다.
 func (h *Heap[T]) Remove() T {
-	var zero T
+	value, _ := h.TryRemove()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *Heap[T]) TryRemove() (T, error) {
 	if h.last_index == 0 {
-		return zero
+		var zero T
+		return zero, ErrEmpty
 	}
 
-	result := *h.array[0]
-	// root node 랑 last node swap
-	h.array[0] = h.array[h.last_index-1]
-	h.array[h.last_index-1] = nil
+	return h.removeAt(0), nil
+}
+
+// idx 위치의 원소를 빼고 그 자리를 마지막 원소로 채운 뒤 힙 속성을 복구한다.
+func (h *Heap[T]) removeAt(idx int) T {
+	result := h.array[idx]
+	var removed *Handle[T]
+	if h.handles != nil {
+		removed = h.handles[idx]
+	}
+
+	// idx node 랑 last node swap
+	last := h.last_index - 1
+	if idx != last {
+		h.swap(idx, last)
+	}
+	// 빠진 자리는 GC 가 회수할 수 있도록 비워둔다
+	var zero T
+	h.array[last] = zero
+	if h.handles != nil {
+		h.handles[last] = nil
+	}
 	h.last_index--
 
-	// 힙에 원소가 남아있으면 heapify-down
-	if h.last_index > 0 {
-		h.moveDown(0)
+	// 빠진 원소의 핸들은 더 이상 유효하지 않음
+	if removed != nil {
+		removed.index = -1
+		removed.heap = nil
+	}
+
+	// 옮겨온 원소가 있으면 위 또는 아래로 복구
+	if idx < h.last_index {
+		h.moveUp(idx)
+		h.moveDown(idx)
 	}
 
+	h.shrinkIfSparse()
 	return result
 }
 
@@ -87,48 +337,182 @@ func (h *Heap[T]) Update(idx int, value T) bool {
 		return false
 	}
 
-	old := (*h.array[idx]).GetPriority()
-	new := value.GetPriority()
+	old := h.array[idx]
 
 	// 값을 실제로 업데이트
-	h.array[idx] = &value
+	h.array[idx] = value
 
-	if old < new {
+	if h.less(value, old) {
 		// 기존보다 우선순위가 높아졌으므로 위로 이동
 		h.moveUp(idx)
-	} else if old > new {
+	} else if h.less(old, value) {
 		// 기존보다 우선순위가 낮아졌으므로 아래로 이동
 		h.moveDown(idx)
 	}
-	// old == new 이면 아무것도 안 해도 됨
+	// 순서가 같으면 아무것도 안 해도 됨
 
 	return true
 }
 
 func (h *Heap[T]) moveDown(curr int) {
 	for {
-		left := curr*2 + 1
-		right := curr*2 + 2
+		left := curr*h.arity + 1
 
 		if left >= h.last_index {
 			break
 		}
 
-		larger := left
-
-		if right < h.last_index && (*h.array[right]).GetPriority() > (*h.array[left]).GetPriority() {
-			larger = right
+		// 자식들 중 가장 앞서는 자식을 찾음
+		first := left
+		last := min(left+h.arity, h.last_index)
+		for child := left + 1; child < last; child++ {
+			if h.less(h.array[child], h.array[first]) {
+				first = child
+			}
 		}
 
-		if (*h.array[curr]).GetPriority() >= (*h.array[larger]).GetPriority() {
-			// 현재가 자식보다 크면 복구 할 필요 없음
+		if !h.less(h.array[first], h.array[curr]) {
+			// 자식이 현재보다 앞서지 않으면 복구 할 필요 없음
 			break
 		}
 
-		tmp := h.array[curr]
-		h.array[curr] = h.array[larger]
-		h.array[larger] = tmp
+		h.swap(curr, first)
+
+		curr = first
+	}
+}
+
+// other 의 모든 원소를 이 힙으로 옮기고 other 는 비운다. other 의 핸들은 이 힙에서 계속 유효하다.
+// 두 배열을 이어붙인 뒤 다시 heapify 하므로 O(n + m) 이다.
+func (h *Heap[T]) Merge(other *Heap[T]) {
+	if other == nil || other == h || other.last_index == 0 {
+		return
+	}
+
+	for h.array_size < h.last_index+other.last_index {
+		h.Resize()
+	}
+	if other.handles != nil {
+		h.trackHandles()
+	}
+
+	for i := 0; i < other.last_index; i++ {
+		h.array[h.last_index] = other.array[i]
+		if other.handles != nil {
+			h.handles[h.last_index] = other.handles[i]
+			if handle := other.handles[i]; handle != nil {
+				handle.heap = h
+				handle.index = h.last_index
+			}
+			other.handles[i] = nil
+		}
+		h.last_index++
+
+		var zero T
+		other.array[i] = zero
+	}
+	other.last_index = 0
+
+	h.heapify()
+}
+
+// Merge 와 같다. PairingHeap, BinomialHeap 과 같은 이름으로 쓸 수 있게 한다.
+func (h *Heap[T]) Meld(other *Heap[T]) {
+	h.Merge(other)
+}
+
+// value 를 넣은 뒤 루트를 꺼내 반환한다. (Python heapq.heappushpop)
+// value 가 루트보다 앞서면 힙을 건드리지 않고 value 를 그대로 반환한다.
+// value 는 핸들 없이 들어간다.
+func (h *Heap[T]) PushPop(value T) T {
+	if h.last_index == 0 || !h.less(h.array[0], value) {
+		return value
+	}
+
+	return h.replaceRoot(value)
+}
+
+// 루트를 꺼내 반환하고 value 를 넣는다. (Python heapq.heapreplace)
+// 빈 힙이면 value 만 넣고 T 의 zero value 를 반환한다. value 는 핸들 없이 들어간다.
+func (h *Heap[T]) Replace(value T) T {
+	if h.last_index == 0 {
+		h.push(value, nil)
+
+		var zero T
+		return zero
+	}
+
+	return h.replaceRoot(value)
+}
+
+// 루트 자리에 value 를 넣고 moveDown 한다. 한 번의 sift 로 Remove + Insert 를 대신한다.
+func (h *Heap[T]) replaceRoot(value T) T {
+	result := h.array[0]
+	if h.handles != nil {
+		if removed := h.handles[0]; removed != nil {
+			removed.index = -1
+			removed.heap = nil
+		}
+		h.handles[0] = nil
+	}
+
+	h.array[0] = value
+	h.moveDown(0)
+
+	return result
+}
+
+// handle 이 이 힙에 남아있는 원소를 가리키고 있는지 확인한다.
+func (h *Heap[T]) Contains(handle *Handle[T]) bool {
+	return handle != nil && handle.heap == h
+}
+
+// handle 이 가리키는 원소를 value 로 바꾸고 자리를 다시 잡는다. 핸들은 계속 유효하다.
+func (h *Heap[T]) UpdateHandle(handle *Handle[T], value T) bool {
+	if !h.Contains(handle) {
+		return false
+	}
+	return h.Update(handle.index, value)
+}
+
+// handle 의 값을 less 기준으로 더 앞서거나 같은 value 로 바꾼다.
+// value 가 기존 값보다 뒤로 가는 값이거나 handle 이 이 힙의 원소가 아니면 false 를 반환한다.
+// 값을 어느 쪽으로든 바꾸려면 UpdateHandle 을 사용한다.
+func (h *Heap[T]) DecreaseKey(handle *Handle[T], value T) bool {
+	if !h.Contains(handle) || h.less(h.array[handle.index], value) {
+		return false
+	}
+	return h.Update(handle.index, value)
+}
 
-		curr = larger
+// handle 이 가리키는 원소를 힙에서 빼서 반환한다. 이후 핸들은 더 이상 유효하지 않다.
+func (h *Heap[T]) RemoveHandle(handle *Handle[T]) (T, bool) {
+	if !h.Contains(handle) {
+		var zero T
+		return zero, false
+	}
+	return h.removeAt(handle.index), true
+}
+
+// 내부 배열에 저장된 순서(레벨 순서)대로 순회한다. 우선순위 순서가 아니다.
+func (h *Heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < h.last_index; i++ {
+			if !yield(h.array[i]) {
+				return
+			}
+		}
+	}
+}
+
+// less 기준으로 앞서는 순서대로 원소를 Remove 하면서 순회한다.
+// 순회를 중간에 멈추면 아직 꺼내지 않은 원소는 힙에 그대로 남는다.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for h.last_index > 0 {
+			if !yield(h.Remove()) {
+				return
+			}
+		}
 	}
 }
@@ -1,6 +1,10 @@
 package heap_test
 
 import (
+	stdheap "container/heap"
+	"errors"
+	"math/rand"
+	"slices"
 	"testing"
 	"time"
 
@@ -605,3 +609,825 @@ func TestUpdateEmptyHeap(t *testing.T) {
 		t.Error("빈 힙에서 Update가 성공했습니다")
 	}
 }
+
+func TestAllStorageOrder(t *testing.T) {
+	h := heap.NewHeap[IntValue](10)
+	for _, v := range []int{10, 20, 15, 30, 40} {
+		h.Insert(IntValue{Value: v})
+	}
+
+	values := []int{}
+	for v := range h.All() {
+		values = append(values, v.Value)
+	}
+
+	if len(values) != 5 {
+		t.Fatalf("All 순회 원소 개수가 올바르지 않습니다. 기대값: 5, 실제값: %d", len(values))
+	}
+
+	// 저장 순서에서도 부모가 자식보다 크거나 같아야 함
+	for i := 1; i < len(values); i++ {
+		if values[(i-1)/2] < values[i] {
+			t.Errorf("All 순회 결과가 힙 속성을 만족하지 않습니다: %v", values)
+		}
+	}
+
+	if h.Size() != 5 {
+		t.Error("All 순회 후 힙이 변경되었습니다")
+	}
+}
+
+func TestDrain(t *testing.T) {
+	h := heap.NewHeap[IntValue](10)
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		h.Insert(IntValue{Value: v})
+	}
+
+	values := []int{}
+	for v := range h.Drain() {
+		if v.Value == 2 {
+			break
+		}
+		values = append(values, v.Value)
+	}
+
+	if !slices.Equal(values, []int{9, 6, 5, 4, 3}) {
+		t.Errorf("Drain 순서가 올바르지 않습니다: %v", values)
+	}
+
+	// 중간에 멈추면 나머지는 힙에 남아있어야 함
+	if h.Size() != 2 {
+		t.Errorf("Drain 중단 후 힙 크기가 올바르지 않습니다. 기대값: 2, 실제값: %d", h.Size())
+	}
+}
+
+func TestTryRemoveAndTryPeek(t *testing.T) {
+	h := heap.NewHeap[IntValue](10)
+
+	if _, err := h.TryPeek(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("빈 힙에서 TryPeek 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := h.TryRemove(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("빈 힙에서 TryRemove 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+
+	// 저장된 zero value 와 빈 힙을 구분할 수 있어야 함
+	h.Insert(IntValue{Value: 0})
+
+	if value, err := h.TryPeek(); err != nil || value.Value != 0 {
+		t.Errorf("TryPeek 이 저장된 0 을 반환하지 않았습니다. 값: %d, 에러: %v", value.Value, err)
+	}
+	if value, err := h.TryRemove(); err != nil || value.Value != 0 {
+		t.Errorf("TryRemove 가 저장된 0 을 반환하지 않았습니다. 값: %d, 에러: %v", value.Value, err)
+	}
+	if h.Size() != 0 {
+		t.Errorf("TryRemove 후 힙의 크기가 0이 아닙니다. 실제값: %d", h.Size())
+	}
+}
+
+func TestHeapFuncMinHeap(t *testing.T) {
+	h := heap.NewHeapFunc(4, func(a int, b int) bool { return a < b })
+
+	for _, v := range []int{5, 3, 8, 1, 9, 2} {
+		h.Insert(v)
+	}
+
+	if got := slices.Collect(h.Drain()); !slices.Equal(got, []int{1, 2, 3, 5, 8, 9}) {
+		t.Errorf("min heap 의 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+}
+
+func TestHeapFuncFloatAndString(t *testing.T) {
+	floats := heap.NewHeapFunc(4, func(a float64, b float64) bool { return a > b })
+	for _, v := range []float64{0.5, 2.25, -1.5, 1.75} {
+		floats.Insert(v)
+	}
+	if got := slices.Collect(floats.Drain()); !slices.Equal(got, []float64{2.25, 1.75, 0.5, -1.5}) {
+		t.Errorf("float max heap 의 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+
+	words := heap.NewHeapFunc(4, func(a string, b string) bool { return a < b })
+	for _, v := range []string{"pear", "apple", "fig", "banana"} {
+		words.Insert(v)
+	}
+	if got := slices.Collect(words.Drain()); !slices.Equal(got, []string{"apple", "banana", "fig", "pear"}) {
+		t.Errorf("string min heap 의 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+}
+
+func TestHeapFuncTieBreaking(t *testing.T) {
+	// 우선순위가 높은 순, 같으면 마감이 이른 순
+	h := heap.NewHeapFunc(4, func(a TaskRecord, b TaskRecord) bool {
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.Deadline.Before(b.Deadline)
+	})
+
+	now := time.Now()
+	h.Insert(TaskRecord{Priority: 1, TaskName: "low", Deadline: now})
+	h.Insert(TaskRecord{Priority: 5, TaskName: "late", Deadline: now.Add(2 * time.Hour)})
+	h.Insert(TaskRecord{Priority: 5, TaskName: "early", Deadline: now.Add(time.Hour)})
+
+	names := []string{}
+	for task := range h.Drain() {
+		names = append(names, task.TaskName)
+	}
+
+	if !slices.Equal(names, []string{"early", "late", "low"}) {
+		t.Errorf("여러 키 비교 순서가 올바르지 않습니다: %v", names)
+	}
+}
+
+func TestMinPriorityAdapter(t *testing.T) {
+	h := heap.NewHeapFunc(4, heap.MinPriority[Job])
+	h.Insert(Job{Priority: 3, JobID: "JOB-3"})
+	h.Insert(Job{Priority: 1, JobID: "JOB-1"})
+	h.Insert(Job{Priority: 2, JobID: "JOB-2"})
+
+	if h.Peek().JobID != "JOB-1" {
+		t.Errorf("MinPriority 힙의 Peek 이 올바르지 않습니다. 기대값: JOB-1, 실제값: %s", h.Peek().JobID)
+	}
+
+	// Update 도 less 기준으로 동작해야 함 (루트의 우선순위를 낮추면 아래로 이동)
+	h.Update(0, Job{Priority: 10, JobID: "JOB-10"})
+	if h.Peek().JobID != "JOB-2" {
+		t.Errorf("Update 후 Peek 이 올바르지 않습니다. 기대값: JOB-2, 실제값: %s", h.Peek().JobID)
+	}
+}
+
+// 저장 순서로 꺼낸 원소들이 힙 속성을 만족하는지 확인
+func checkHeapInvariant[T any](t *testing.T, h *heap.Heap[T], less func(a T, b T) bool) {
+	t.Helper()
+	checkDaryHeapInvariant(t, h, 2, less)
+}
+
+func checkDaryHeapInvariant[T any](t *testing.T, h *heap.Heap[T], arity int, less func(a T, b T) bool) {
+	t.Helper()
+
+	values := slices.Collect(h.All())
+	for i := 1; i < len(values); i++ {
+		if less(values[i], values[(i-1)/arity]) {
+			t.Fatalf("힙 속성이 깨졌습니다. 인덱스 %d 의 값이 부모보다 앞섭니다", i)
+		}
+	}
+}
+
+func TestHandleUpdateAndRemove(t *testing.T) {
+	less := func(a int, b int) bool { return a < b }
+	h := heap.NewHeapFunc(4, less)
+
+	handles := map[int]*heap.Handle[int]{}
+	for _, v := range []int{50, 30, 40, 10, 20} {
+		handles[v] = h.Insert(v)
+	}
+
+	// decrease-key: 50 을 5 로 바꾸면 루트로 올라와야 함
+	if !h.UpdateHandle(handles[50], 5) {
+		t.Error("UpdateHandle 이 실패했습니다")
+	}
+	if h.Peek() != 5 {
+		t.Errorf("UpdateHandle 후 Peek 이 올바르지 않습니다. 기대값: 5, 실제값: %d", h.Peek())
+	}
+	checkHeapInvariant(t, h, less)
+
+	// 중간 원소 제거
+	if v, ok := h.RemoveHandle(handles[30]); !ok || v != 30 {
+		t.Errorf("RemoveHandle 기대값: 30, 실제값: %d", v)
+	}
+	if h.Contains(handles[30]) {
+		t.Error("제거된 핸들을 Contains 가 true 로 판단했습니다")
+	}
+	if _, ok := h.RemoveHandle(handles[30]); ok {
+		t.Error("이미 제거된 핸들로 RemoveHandle 이 성공했습니다")
+	}
+	if h.UpdateHandle(handles[30], 1) {
+		t.Error("이미 제거된 핸들로 UpdateHandle 이 성공했습니다")
+	}
+	checkHeapInvariant(t, h, less)
+
+	// Remove 로 빠진 원소의 핸들도 무효가 되어야 함
+	if h.Remove() != 5 || h.Contains(handles[50]) {
+		t.Error("Remove 후 루트 핸들이 무효가 되지 않았습니다")
+	}
+
+	if got := slices.Collect(h.Drain()); !slices.Equal(got, []int{10, 20, 40}) {
+		t.Errorf("남은 원소의 순서가 올바르지 않습니다: %v", got)
+	}
+}
+
+func TestHandleFromOtherHeap(t *testing.T) {
+	a := heap.NewHeap[IntValue](4)
+	b := heap.NewHeap[IntValue](4)
+
+	handle := a.Insert(IntValue{Value: 1})
+	b.Insert(IntValue{Value: 2})
+
+	if b.Contains(handle) || b.UpdateHandle(handle, IntValue{Value: 3}) {
+		t.Error("다른 힙의 핸들이 사용되었습니다")
+	}
+	if _, ok := b.RemoveHandle(handle); ok {
+		t.Error("다른 힙의 핸들로 RemoveHandle 이 성공했습니다")
+	}
+	if b.Contains(nil) {
+		t.Error("nil 핸들을 Contains 가 true 로 판단했습니다")
+	}
+}
+
+func TestHandleRandomOperations(t *testing.T) {
+	less := func(a int, b int) bool { return a < b }
+	h := heap.NewHeapFunc(2, less)
+	r := rand.New(rand.NewSource(42))
+
+	// 살아있는 핸들과 그 핸들이 가리키는 값
+	live := map[*heap.Handle[int]]int{}
+	keys := func() []*heap.Handle[int] {
+		result := []*heap.Handle[int]{}
+		for handle := range live {
+			result = append(result, handle)
+		}
+		// map 순회 순서에 관계없이 재현 가능하도록 값 기준으로 정렬
+		slices.SortFunc(result, func(a *heap.Handle[int], b *heap.Handle[int]) int { return live[a] - live[b] })
+		return result
+	}
+
+	for step := 0; step < 5000; step++ {
+		switch op := r.Intn(4); {
+		case op == 0 || len(live) == 0:
+			v := r.Intn(1000)
+			live[h.Insert(v)] = v
+		case op == 1:
+			v := h.Remove()
+			found := false
+			for handle, lv := range live {
+				if !h.Contains(handle) {
+					if lv != v {
+						t.Fatalf("Remove 가 핸들과 다른 값을 반환했습니다. 기대값: %d, 실제값: %d", lv, v)
+					}
+					delete(live, handle)
+					found = true
+				}
+			}
+			if !found {
+				t.Fatal("Remove 로 빠진 원소의 핸들이 무효가 되지 않았습니다")
+			}
+		case op == 2:
+			all := keys()
+			handle := all[r.Intn(len(all))]
+			v := r.Intn(1000)
+			if !h.UpdateHandle(handle, v) {
+				t.Fatal("UpdateHandle 이 실패했습니다")
+			}
+			live[handle] = v
+		default:
+			all := keys()
+			handle := all[r.Intn(len(all))]
+			v, ok := h.RemoveHandle(handle)
+			if !ok || v != live[handle] {
+				t.Fatalf("RemoveHandle 기대값: %d, 실제값: %d", live[handle], v)
+			}
+			delete(live, handle)
+		}
+
+		if h.Size() != len(live) {
+			t.Fatalf("힙 크기가 올바르지 않습니다. 기대값: %d, 실제값: %d", len(live), h.Size())
+		}
+		for handle := range live {
+			if !h.Contains(handle) {
+				t.Fatal("살아있는 원소의 핸들이 무효가 되었습니다")
+			}
+		}
+		checkHeapInvariant(t, h, less)
+	}
+
+	// 남은 원소는 정렬된 순서로 나와야 함
+	expected := []int{}
+	for _, v := range live {
+		expected = append(expected, v)
+	}
+	slices.Sort(expected)
+	if got := slices.Collect(h.Drain()); !slices.Equal(got, expected) {
+		t.Errorf("남은 원소의 순서가 올바르지 않습니다. 기대값: %v, 실제값: %v", expected, got)
+	}
+}
+
+func TestNewHeapFrom(t *testing.T) {
+	items := []IntValue{}
+	for i := 1; i <= 1000; i++ {
+		items = append(items, IntValue{Value: i * 7 % 997})
+	}
+
+	h := heap.NewHeapFrom(items)
+	if h.Size() != len(items) {
+		t.Fatalf("힙 크기가 올바르지 않습니다. 기대값: %d, 실제값: %d", len(items), h.Size())
+	}
+	checkHeapInvariant(t, h, heap.MaxPriority[IntValue])
+
+	// 원본 슬라이스는 바뀌지 않아야 함
+	if items[0].Value != 7 {
+		t.Error("NewHeapFrom 이 원본 슬라이스를 변경했습니다")
+	}
+
+	prev := h.Remove()
+	for h.Size() > 0 {
+		current := h.Remove()
+		if current.Value > prev.Value {
+			t.Fatalf("Remove 순서가 올바르지 않습니다. 이전값: %d, 현재값: %d", prev.Value, current.Value)
+		}
+		prev = current
+	}
+
+	// 빈 슬라이스로도 만들 수 있어야 함
+	empty := heap.NewHeapFromFunc([]int{}, func(a int, b int) bool { return a < b })
+	empty.Insert(3)
+	empty.Insert(1)
+	if empty.Peek() != 1 {
+		t.Errorf("빈 슬라이스로 만든 힙이 올바르게 동작하지 않습니다. 실제값: %d", empty.Peek())
+	}
+}
+
+func TestMerge(t *testing.T) {
+	less := func(a int, b int) bool { return a < b }
+	a := heap.NewHeapFromFunc([]int{5, 1, 9}, less)
+	b := heap.NewHeapFunc(2, less)
+	handle := b.Insert(7)
+	b.Insert(3)
+	b.Insert(0)
+
+	a.Merge(b)
+
+	if a.Size() != 6 || b.Size() != 0 {
+		t.Errorf("Merge 후 크기가 올바르지 않습니다. a: %d, b: %d", a.Size(), b.Size())
+	}
+	checkHeapInvariant(t, a, less)
+
+	// other 의 핸들은 합쳐진 힙에서 계속 사용할 수 있어야 함
+	if !a.Contains(handle) || b.Contains(handle) {
+		t.Error("Merge 후 핸들이 합쳐진 힙으로 옮겨지지 않았습니다")
+	}
+	if v, ok := a.RemoveHandle(handle); !ok || v != 7 {
+		t.Errorf("Merge 후 RemoveHandle 기대값: 7, 실제값: %d", v)
+	}
+
+	if got := slices.Collect(a.Drain()); !slices.Equal(got, []int{0, 1, 3, 5, 9}) {
+		t.Errorf("Merge 후 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+
+	// 비워진 other 는 다시 사용할 수 있어야 함
+	b.Insert(4)
+	if b.Peek() != 4 || b.Size() != 1 {
+		t.Error("Merge 후 비워진 힙을 재사용할 수 없습니다")
+	}
+
+	b.Merge(b)
+	b.Merge(nil)
+	if b.Size() != 1 {
+		t.Error("자기 자신이나 nil 과의 Merge 가 힙을 변경했습니다")
+	}
+}
+
+func TestPushPop(t *testing.T) {
+	less := func(a int, b int) bool { return a < b }
+	h := heap.NewHeapFromFunc([]int{3, 5, 7}, less)
+
+	// 루트보다 앞서는 값은 그대로 반환
+	if v := h.PushPop(1); v != 1 {
+		t.Errorf("PushPop 기대값: 1, 실제값: %d", v)
+	}
+	if h.Size() != 3 || h.Peek() != 3 {
+		t.Error("루트보다 앞서는 값으로 PushPop 했는데 힙이 변경되었습니다")
+	}
+
+	if v := h.PushPop(6); v != 3 {
+		t.Errorf("PushPop 기대값: 3, 실제값: %d", v)
+	}
+	checkHeapInvariant(t, h, less)
+
+	empty := heap.NewHeapFunc(1, less)
+	if v := empty.PushPop(9); v != 9 || empty.Size() != 0 {
+		t.Error("빈 힙에서 PushPop 이 값을 그대로 반환하지 않았습니다")
+	}
+
+	if got := slices.Collect(h.Drain()); !slices.Equal(got, []int{5, 6, 7}) {
+		t.Errorf("PushPop 후 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	less := func(a int, b int) bool { return a < b }
+	h := heap.NewHeapFunc(2, less)
+	root := h.Insert(3)
+	h.Insert(5)
+
+	// PushPop 과 달리 value 가 루트보다 앞서도 루트를 먼저 꺼냄
+	if v := h.Replace(1); v != 3 {
+		t.Errorf("Replace 기대값: 3, 실제값: %d", v)
+	}
+	if h.Contains(root) {
+		t.Error("Replace 로 빠진 원소의 핸들이 무효가 되지 않았습니다")
+	}
+	if h.Size() != 2 || h.Peek() != 1 {
+		t.Errorf("Replace 후 힙이 올바르지 않습니다. 크기: %d, Peek: %d", h.Size(), h.Peek())
+	}
+
+	if v := h.Replace(8); v != 1 {
+		t.Errorf("Replace 기대값: 1, 실제값: %d", v)
+	}
+	checkHeapInvariant(t, h, less)
+
+	empty := heap.NewHeapFunc(1, less)
+	if v := empty.Replace(4); v != 0 || empty.Size() != 1 || empty.Peek() != 4 {
+		t.Error("빈 힙에서 Replace 가 올바르게 동작하지 않았습니다")
+	}
+}
+
+func TestZeroAndNegativeSize(t *testing.T) {
+	for _, size := range []int{0, -5} {
+		h := heap.NewHeap[IntValue](size)
+
+		if h.Cap() != 0 {
+			t.Errorf("NewHeap(%d) 의 Cap 이 0이 아닙니다. 실제값: %d", size, h.Cap())
+		}
+
+		// 첫 Insert 에서 panic 없이 늘어나야 함
+		for i := 1; i <= 5; i++ {
+			h.Insert(IntValue{Value: i})
+		}
+
+		if h.Size() != 5 || h.Peek().Value != 5 {
+			t.Errorf("NewHeap(%d) 에 Insert 후 힙이 올바르지 않습니다. 크기: %d, Peek: %d", size, h.Size(), h.Peek().Value)
+		}
+	}
+}
+
+func TestCapGrowth(t *testing.T) {
+	h := heap.NewHeap[IntValue](2)
+
+	expected := []int{2, 2, 4, 4, 8}
+	for i, exp := range expected {
+		h.Insert(IntValue{Value: i})
+		if h.Cap() != exp {
+			t.Errorf("%d 개 삽입 후 Cap 기대값: %d, 실제값: %d", i+1, exp, h.Cap())
+		}
+	}
+}
+
+func TestAutoShrink(t *testing.T) {
+	h := heap.NewHeap[IntValue](4)
+	for i := 0; i < 16; i++ {
+		h.Insert(IntValue{Value: i})
+	}
+	if h.Cap() != 16 {
+		t.Fatalf("Cap 기대값: 16, 실제값: %d", h.Cap())
+	}
+
+	// 용량의 1/4 보다 많으면 줄어들지 않음
+	for h.Size() > 5 {
+		h.Remove()
+	}
+	if h.Cap() != 16 {
+		t.Errorf("너무 일찍 줄어들었습니다. Cap 기대값: 16, 실제값: %d", h.Cap())
+	}
+
+	// 용량의 1/4 이 되면 절반으로 줄어듦
+	h.Remove()
+	if h.Cap() != 8 {
+		t.Errorf("용량의 1/4 에서 줄어들지 않았습니다. Cap 기대값: 8, 실제값: %d", h.Cap())
+	}
+	checkHeapInvariant(t, h, heap.MaxPriority[IntValue])
+
+	// 생성 시 크기가 작아도 8 밑으로는 줄어들지 않음
+	for h.Size() > 0 {
+		h.Remove()
+	}
+	if h.Cap() != 8 {
+		t.Errorf("자동으로 줄어들 때의 하한 밑으로 줄어들었습니다. Cap 기대값: 8, 실제값: %d", h.Cap())
+	}
+
+	// 생성 시 크기가 더 크면 그 크기가 하한
+	big := heap.NewHeap[IntValue](32)
+	for i := 0; i < 64; i++ {
+		big.Insert(IntValue{Value: i})
+	}
+	for big.Size() > 0 {
+		big.Remove()
+	}
+	if big.Cap() != 32 {
+		t.Errorf("생성 시 크기 밑으로 줄어들었습니다. Cap 기대값: 32, 실제값: %d", big.Cap())
+	}
+}
+
+func TestPushWithoutHandles(t *testing.T) {
+	// Push 만 쓰면 배열이 늘어날 때(1, 2, 4, ..., 128) 말고는 할당이 없어야 함
+	allocs := testing.AllocsPerRun(10, func() {
+		h := heap.NewHeapFunc(0, heap.MaxPriority[IntValue])
+		for i := 0; i < 100; i++ {
+			h.Push(IntValue{Value: i})
+		}
+	})
+	if allocs > 8 {
+		t.Errorf("Push 100 번의 할당 기대값: 배열이 늘어나는 8 번 이하, 실제값: %v", allocs)
+	}
+
+	// Push 로 넣은 원소 사이에 Insert 해도 핸들이 제자리를 따라가야 함
+	h := heap.NewHeapFunc(0, heap.MaxPriority[IntValue])
+	for i := 0; i < 10; i++ {
+		h.Push(IntValue{Value: i * 2})
+	}
+	handle := h.Insert(IntValue{Value: 7})
+	for i := 0; i < 10; i++ {
+		h.Push(IntValue{Value: i*2 + 1})
+	}
+	checkHeapInvariant(t, h, heap.MaxPriority[IntValue])
+
+	if !h.UpdateHandle(handle, IntValue{Value: 100}) || h.Peek().Value != 100 {
+		t.Errorf("Push 와 섞어 Insert 한 핸들이 올바르지 않습니다. Peek: %d", h.Peek().Value)
+	}
+	if v, ok := h.RemoveHandle(handle); !ok || v.Value != 100 || h.Contains(handle) {
+		t.Error("Push 와 섞어 Insert 한 핸들로 RemoveHandle 이 실패했습니다")
+	}
+	checkHeapInvariant(t, h, heap.MaxPriority[IntValue])
+
+	// 핸들이 없는 힙을 핸들이 있는 힙에 합쳐도 핸들이 유지되어야 함
+	other := heap.NewHeapFunc(0, heap.MaxPriority[IntValue])
+	kept := other.Insert(IntValue{Value: 50})
+	plain := heap.NewHeapFrom([]IntValue{{Value: 3}, {Value: 60}})
+	other.Merge(plain)
+	plain.Merge(other)
+	if !plain.Contains(kept) || plain.Peek().Value != 60 {
+		t.Error("Merge 후 핸들이 유지되지 않았습니다")
+	}
+	if !plain.DecreaseKey(kept, IntValue{Value: 70}) || plain.Remove().Value != 70 {
+		t.Error("Merge 로 옮겨진 핸들로 DecreaseKey 가 실패했습니다")
+	}
+}
+
+func TestDrainedHeapAllocs(t *testing.T) {
+	items := make([]IntValue, 100)
+	for i := range items {
+		items[i] = IntValue{Value: i}
+	}
+
+	heaps := map[string]*heap.Heap[IntValue]{
+		"NewHeapFunc(0)":  heap.NewHeapFunc(0, heap.MaxPriority[IntValue]),
+		"NewHeapFromFunc": heap.NewHeapFromFunc(items, heap.MaxPriority[IntValue]),
+	}
+	for name, h := range heaps {
+		for i := 0; i < 100; i++ {
+			h.Push(IntValue{Value: i})
+		}
+		for h.Size() > 0 {
+			h.Remove()
+		}
+
+		// 다 비운 힙에 넣고 빼기를 반복해도 배열을 다시 할당하지 않아야 함
+		allocs := testing.AllocsPerRun(100, func() {
+			h.Push(IntValue{Value: 1})
+			h.Remove()
+		})
+		if allocs != 0 {
+			t.Errorf("%s: 비운 힙에서 Push/Remove 가 %v 번 할당했습니다", name, allocs)
+		}
+	}
+}
+
+func TestShrink(t *testing.T) {
+	h := heap.NewHeap[IntValue](10)
+	for i := 0; i < 3; i++ {
+		h.Insert(IntValue{Value: i})
+	}
+
+	h.Shrink()
+	if h.Cap() != 3 {
+		t.Errorf("Shrink 후 Cap 기대값: 3, 실제값: %d", h.Cap())
+	}
+	if h.Peek().Value != 2 || h.Size() != 3 {
+		t.Error("Shrink 가 원소를 변경했습니다")
+	}
+
+	// 딱 맞게 줄인 후에도 다시 늘어날 수 있어야 함
+	h.Insert(IntValue{Value: 9})
+	if h.Cap() != 6 || h.Peek().Value != 9 {
+		t.Errorf("Shrink 후 Insert 가 올바르게 동작하지 않았습니다. Cap: %d, Peek: %d", h.Cap(), h.Peek().Value)
+	}
+
+	// 빈 힙을 Shrink 하면 0 이 되고, 다시 Insert 할 수 있어야 함
+	empty := heap.NewHeap[IntValue](8)
+	empty.Shrink()
+	empty.Insert(IntValue{Value: 1})
+	if empty.Peek().Value != 1 {
+		t.Error("빈 힙을 Shrink 한 후 Insert 가 올바르게 동작하지 않았습니다")
+	}
+}
+
+func TestClear(t *testing.T) {
+	h := heap.NewHeap[IntValue](2)
+	handle := h.Insert(IntValue{Value: 1})
+	for i := 2; i <= 20; i++ {
+		h.Insert(IntValue{Value: i})
+	}
+
+	h.Clear()
+
+	if h.Size() != 0 || h.Cap() != 2 {
+		t.Errorf("Clear 후 힙이 초기 상태가 아닙니다. 크기: %d, Cap: %d", h.Size(), h.Cap())
+	}
+	if h.Contains(handle) {
+		t.Error("Clear 후 핸들이 무효가 되지 않았습니다")
+	}
+
+	h.Insert(IntValue{Value: 42})
+	if h.Peek().Value != 42 {
+		t.Error("Clear 후 힙을 재사용할 수 없습니다")
+	}
+}
+
+func TestPushWithoutHandle(t *testing.T) {
+	h := heap.NewHeapFunc(0, func(a int, b int) bool { return a < b })
+
+	for _, v := range []int{4, 2, 8, 6} {
+		h.Push(v)
+	}
+	handle := h.Insert(5)
+
+	if v, ok := h.RemoveHandle(handle); !ok || v != 5 {
+		t.Errorf("Push 와 섞인 힙에서 RemoveHandle 기대값: 5, 실제값: %d", v)
+	}
+	if got := slices.Collect(h.Drain()); !slices.Equal(got, []int{2, 4, 6, 8}) {
+		t.Errorf("Push 후 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+}
+
+// 벤치마크용 작은 구조체
+type benchItem struct {
+	priority int
+	id       int
+}
+
+func benchLess(a benchItem, b benchItem) bool {
+	return a.priority < b.priority
+}
+
+// container/heap 비교 대상
+type stdItems []benchItem
+
+func (s stdItems) Len() int           { return len(s) }
+func (s stdItems) Less(i, j int) bool { return s[i].priority < s[j].priority }
+func (s stdItems) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s *stdItems) Push(x any)        { *s = append(*s, x.(benchItem)) }
+func (s *stdItems) Pop() any {
+	old := *s
+	n := len(old)
+	item := old[n-1]
+	*s = old[:n-1]
+	return item
+}
+
+const benchSize = 1000
+
+func benchPriorities() []int {
+	r := rand.New(rand.NewSource(1))
+	priorities := make([]int, benchSize)
+	for i := range priorities {
+		priorities[i] = r.Intn(1_000_000)
+	}
+	return priorities
+}
+
+func BenchmarkHeapInsertRemove(b *testing.B) {
+	priorities := benchPriorities()
+	b.ReportAllocs()
+	for b.Loop() {
+		h := heap.NewHeapFunc(benchSize, benchLess)
+		for i, p := range priorities {
+			h.Insert(benchItem{priority: p, id: i})
+		}
+		for h.Size() > 0 {
+			h.Remove()
+		}
+	}
+}
+
+func BenchmarkHeapPushRemove(b *testing.B) {
+	priorities := benchPriorities()
+	b.ReportAllocs()
+	for b.Loop() {
+		h := heap.NewHeapFunc(benchSize, benchLess)
+		for i, p := range priorities {
+			h.Push(benchItem{priority: p, id: i})
+		}
+		for h.Size() > 0 {
+			h.Remove()
+		}
+	}
+}
+
+func BenchmarkContainerHeapPushPop(b *testing.B) {
+	priorities := benchPriorities()
+	b.ReportAllocs()
+	for b.Loop() {
+		items := make(stdItems, 0, benchSize)
+		for i, p := range priorities {
+			stdheap.Push(&items, benchItem{priority: p, id: i})
+		}
+		for items.Len() > 0 {
+			stdheap.Pop(&items)
+		}
+	}
+}
+
+func TestDaryHeap(t *testing.T) {
+	less := func(a int, b int) bool { return a < b }
+
+	for _, arity := range []int{2, 3, 4, 8} {
+		h := heap.NewDaryHeapFunc(arity, 0, less)
+		r := rand.New(rand.NewSource(int64(arity)))
+
+		handles := []*heap.Handle[int]{}
+		for i := 0; i < 500; i++ {
+			handles = append(handles, h.Insert(r.Intn(10000)))
+		}
+		checkDaryHeapInvariant(t, h, arity, less)
+
+		// decrease-key 와 임의 제거를 섞음
+		for i, handle := range handles {
+			if i%3 == 0 {
+				h.UpdateHandle(handle, -i)
+			} else if i%7 == 0 {
+				h.RemoveHandle(handle)
+			}
+			checkDaryHeapInvariant(t, h, arity, less)
+		}
+
+		values := slices.Collect(h.Drain())
+		if !slices.IsSorted(values) {
+			t.Errorf("arity %d: Remove 순서가 정렬되어 있지 않습니다", arity)
+		}
+	}
+}
+
+func TestDaryHeapFromPrioritized(t *testing.T) {
+	h := heap.NewDaryHeap[IntValue](4, 0)
+	for _, v := range []int{3, 9, 1, 7, 5, 8, 2} {
+		h.Insert(IntValue{Value: v})
+	}
+
+	values := []int{}
+	for v := range h.Drain() {
+		values = append(values, v.Value)
+	}
+	if !slices.Equal(values, []int{9, 8, 7, 5, 3, 2, 1}) {
+		t.Errorf("4-ary max heap 의 Remove 순서가 올바르지 않습니다: %v", values)
+	}
+
+	// arity 가 2 보다 작으면 이진 힙으로 동작
+	binary := heap.NewDaryHeapFunc(0, 0, func(a int, b int) bool { return a < b })
+	binary.Push(2)
+	binary.Push(1)
+	if binary.Peek() != 1 {
+		t.Error("arity 0 으로 만든 힙이 올바르게 동작하지 않습니다")
+	}
+}
+
+// Insert/Remove 가 섞인 일반적인 사용
+func benchmarkDaryInsertRemove(b *testing.B, arity int) {
+	priorities := benchPriorities()
+	b.ReportAllocs()
+	for b.Loop() {
+		h := heap.NewDaryHeapFunc(arity, benchSize, benchLess)
+		for i, p := range priorities {
+			h.Push(benchItem{priority: p, id: i})
+		}
+		for h.Size() > 0 {
+			h.Remove()
+		}
+	}
+}
+
+// Dijkstra 처럼 decrease-key 가 Remove 보다 훨씬 많은 경우
+func benchmarkDaryDecreaseKey(b *testing.B, arity int) {
+	priorities := benchPriorities()
+	b.ReportAllocs()
+	for b.Loop() {
+		h := heap.NewDaryHeapFunc(arity, benchSize, benchLess)
+		handles := make([]*heap.Handle[benchItem], len(priorities))
+		for i, p := range priorities {
+			handles[i] = h.Insert(benchItem{priority: p, id: i})
+		}
+		for round := 1; round <= 8; round++ {
+			for i, handle := range handles {
+				h.UpdateHandle(handle, benchItem{priority: priorities[i] - round*100_000, id: i})
+			}
+		}
+		for h.Size() > 0 {
+			h.Remove()
+		}
+	}
+}
+
+func BenchmarkDaryInsertRemove2(b *testing.B) { benchmarkDaryInsertRemove(b, 2) }
+func BenchmarkDaryInsertRemove4(b *testing.B) { benchmarkDaryInsertRemove(b, 4) }
+func BenchmarkDaryInsertRemove8(b *testing.B) { benchmarkDaryInsertRemove(b, 8) }
+func BenchmarkDaryDecreaseKey2(b *testing.B)  { benchmarkDaryDecreaseKey(b, 2) }
+func BenchmarkDaryDecreaseKey4(b *testing.B)  { benchmarkDaryDecreaseKey(b, 4) }
+func BenchmarkDaryDecreaseKey8(b *testing.B)  { benchmarkDaryDecreaseKey(b, 8) }
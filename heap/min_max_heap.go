@@ -0,0 +1,256 @@
+package heap
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// MinMaxHeap 은 양쪽 끝을 모두 O(log n) 에 꺼낼 수 있는 배열 기반 double-ended priority queue 이다.
+// 짝수 레벨(루트 포함)의 노드는 서브트리에서 less 기준으로 가장 앞서는 값을, 홀수 레벨의 노드는 가장 뒤에 있는 값을 가진다.
+// Min 은 less 기준으로 가장 앞서는 원소, Max 는 가장 뒤에 있는 원소이다.
+type MinMaxHeap[T any] struct {
+	array    []T
+	capacity int // 0 이면 제한 없음
+	less     func(a T, b T) bool
+}
+
+// GetPriority 가 가장 작은 값이 Min, 가장 큰 값이 Max 인 min-max heap 을 만든다.
+func NewMinMaxHeap[T Prioritized]() *MinMaxHeap[T] {
+	return NewMinMaxHeapFunc(MinPriority[T])
+}
+
+func NewMinMaxHeapFunc[T any](less func(a T, b T) bool) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{less: less}
+}
+
+// 원소를 최대 capacity 개만 유지하는 min-max heap 을 만든다.
+// 가득 찬 상태에서 넣으면 Max 쪽 원소(새로 넣은 원소 포함)를 하나 버린다. capacity 가 0 이하이면 제한이 없다.
+func NewBoundedMinMaxHeapFunc[T any](capacity int, less func(a T, b T) bool) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{
+		array:    make([]T, 0, max(capacity, 0)),
+		capacity: max(capacity, 0),
+		less:     less,
+	}
+}
+
+func (h *MinMaxHeap[T]) Size() int {
+	return len(h.array)
+}
+
+// 유지할 수 있는 최대 원소 수. 제한이 없으면 0 이다.
+func (h *MinMaxHeap[T]) Capacity() int {
+	return h.capacity
+}
+
+func (h *MinMaxHeap[T]) IsFull() bool {
+	return h.capacity > 0 && len(h.array) >= h.capacity
+}
+
+func isMinLevel(idx int) bool {
+	return bits.Len(uint(idx+1))%2 == 1
+}
+
+// idx 가 속한 레벨에서 앞서야 하는 쪽이면 true. 짝수 레벨은 less, 홀수 레벨은 반대 순서이다.
+func (h *MinMaxHeap[T]) before(idx int, a T, b T) bool {
+	if isMinLevel(idx) {
+		return h.less(a, b)
+	}
+	return h.less(b, a)
+}
+
+func (h *MinMaxHeap[T]) Push(value T) {
+	h.Offer(value)
+}
+
+// value 를 넣는다. 용량을 넘어서 원소를 버렸으면 버린 원소와 true 를 반환한다.
+func (h *MinMaxHeap[T]) Offer(value T) (T, bool) {
+	if h.IsFull() {
+		max_idx := h.maxIndex()
+		// 새 원소가 가장 뒤에 있으면 넣지 않고 그대로 버린다
+		if !h.less(value, h.array[max_idx]) {
+			return value, true
+		}
+		dropped := h.removeAt(max_idx)
+		h.array = append(h.array, value)
+		h.moveUp(len(h.array) - 1)
+		return dropped, true
+	}
+
+	h.array = append(h.array, value)
+	h.moveUp(len(h.array) - 1)
+
+	var zero T
+	return zero, false
+}
+
+func (h *MinMaxHeap[T]) moveUp(curr int) {
+	if curr == 0 {
+		return
+	}
+
+	parent := (curr - 1) / 2
+	if h.before(parent, h.array[curr], h.array[parent]) {
+		// 부모 레벨 쪽에 있어야 하는 값이면 부모와 바꾼 뒤 부모 레벨을 따라 올라간다
+		h.array[curr], h.array[parent] = h.array[parent], h.array[curr]
+		h.moveUpLevel(parent)
+	} else {
+		h.moveUpLevel(curr)
+	}
+}
+
+// 같은 종류의 레벨(조부모)을 따라 올라간다.
+func (h *MinMaxHeap[T]) moveUpLevel(curr int) {
+	for curr > 2 {
+		grand := ((curr-1)/2 - 1) / 2
+		if !h.before(curr, h.array[curr], h.array[grand]) {
+			return
+		}
+		h.array[curr], h.array[grand] = h.array[grand], h.array[curr]
+		curr = grand
+	}
+}
+
+func (h *MinMaxHeap[T]) moveDown(curr int) {
+	size := len(h.array)
+
+	for {
+		// 자식과 손자 중 curr 의 레벨 기준으로 가장 앞서는 것
+		best := -1
+		first_child := 2*curr + 1
+		for _, idx := range []int{first_child, first_child + 1, 2*first_child + 1, 2*first_child + 2, 2*first_child + 3, 2*first_child + 4} {
+			if idx >= size {
+				continue
+			}
+			if best == -1 || h.before(curr, h.array[idx], h.array[best]) {
+				best = idx
+			}
+		}
+
+		if best == -1 || !h.before(curr, h.array[best], h.array[curr]) {
+			return
+		}
+		h.array[curr], h.array[best] = h.array[best], h.array[curr]
+
+		// 자식이면 그 아래는 다른 종류의 레벨이므로 더 내려갈 필요가 없다
+		if best <= first_child+1 {
+			return
+		}
+
+		// 손자와 바꿨으면 그 사이 부모(반대 레벨)와의 순서도 맞춘다
+		parent := (best - 1) / 2
+		if h.before(parent, h.array[best], h.array[parent]) {
+			h.array[best], h.array[parent] = h.array[parent], h.array[best]
+		}
+		curr = best
+	}
+}
+
+// Max 원소의 위치. 빈 힙이면 -1 이다.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch len(h.array) {
+	case 0:
+		return -1
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if h.less(h.array[1], h.array[2]) {
+		return 2
+	}
+	return 1
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다.
+func (h *MinMaxHeap[T]) PeekMin() T {
+	value, _ := h.TryPeekMin()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *MinMaxHeap[T]) TryPeekMin() (T, error) {
+	if len(h.array) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.array[0], nil
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다.
+func (h *MinMaxHeap[T]) PeekMax() T {
+	value, _ := h.TryPeekMax()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *MinMaxHeap[T]) TryPeekMax() (T, error) {
+	idx := h.maxIndex()
+	if idx == -1 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.array[idx], nil
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryPopMin 을 사용한다.
+func (h *MinMaxHeap[T]) PopMin() T {
+	value, _ := h.TryPopMin()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *MinMaxHeap[T]) TryPopMin() (T, error) {
+	if len(h.array) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.removeAt(0), nil
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryPopMax 를 사용한다.
+func (h *MinMaxHeap[T]) PopMax() T {
+	value, _ := h.TryPopMax()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *MinMaxHeap[T]) TryPopMax() (T, error) {
+	idx := h.maxIndex()
+	if idx == -1 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.removeAt(idx), nil
+}
+
+// idx 자리에 마지막 원소를 옮기고 moveDown 한다. idx 는 루트이거나 루트의 자식이다.
+func (h *MinMaxHeap[T]) removeAt(idx int) T {
+	last := len(h.array) - 1
+	value := h.array[idx]
+
+	h.array[idx] = h.array[last]
+	var zero T
+	h.array[last] = zero
+	h.array = h.array[:last]
+
+	if idx < last {
+		h.moveDown(idx)
+	}
+	return value
+}
+
+func (h *MinMaxHeap[T]) Clear() {
+	clear(h.array)
+	h.array = h.array[:0]
+}
+
+// 내부 배열 순서로 순회한다. less 순서가 아니다.
+func (h *MinMaxHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range h.array {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
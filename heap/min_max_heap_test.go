@@ -0,0 +1,159 @@
+package heap_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	heap "github.com/tmdgusya/go-data-structure/heap"
+)
+
+func TestMinMaxHeapPopBothEnds(t *testing.T) {
+	h := heap.NewMinMaxHeapFunc(intLess)
+	for _, v := range []int{5, 9, 1, 7, 3, 8, 2} {
+		h.Push(v)
+	}
+
+	if h.PeekMin() != 1 || h.PeekMax() != 9 {
+		t.Errorf("PeekMin/PeekMax 기대값: 1/9, 실제값: %d/%d", h.PeekMin(), h.PeekMax())
+	}
+
+	expected := []int{1, 9, 2, 8, 3, 7, 5}
+	actual := []int{}
+	for i := 0; h.Size() > 0; i++ {
+		if i%2 == 0 {
+			actual = append(actual, h.PopMin())
+		} else {
+			actual = append(actual, h.PopMax())
+		}
+	}
+	if !slices.Equal(actual, expected) {
+		t.Errorf("꺼낸 순서 기대값: %v, 실제값: %v", expected, actual)
+	}
+}
+
+func TestMinMaxHeapEmpty(t *testing.T) {
+	h := heap.NewMinMaxHeapFunc(intLess)
+
+	if _, err := h.TryPeekMin(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("빈 힙에서 TryPeekMin 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := h.TryPeekMax(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("빈 힙에서 TryPeekMax 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := h.TryPopMin(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("빈 힙에서 TryPopMin 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := h.TryPopMax(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("빈 힙에서 TryPopMax 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if v := h.PopMax(); v != 0 {
+		t.Errorf("빈 힙에서 PopMax 는 zero value 를 반환해야 합니다. 실제값: %d", v)
+	}
+}
+
+func TestMinMaxHeapPrioritized(t *testing.T) {
+	h := heap.NewMinMaxHeap[IntValue]()
+	for _, v := range []int{4, 10, 1} {
+		h.Push(IntValue{v})
+	}
+
+	if h.PopMin().Value != 1 || h.PopMax().Value != 10 {
+		t.Error("GetPriority 가 가장 작은 값이 Min, 가장 큰 값이 Max 여야 합니다")
+	}
+}
+
+func TestMinMaxHeapRandomAgainstSort(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	h := heap.NewMinMaxHeapFunc(intLess)
+	oracle := []int{}
+
+	for step := 0; step < 5000; step++ {
+		switch op := r.Intn(4); {
+		case op < 2 || len(oracle) == 0:
+			v := r.Intn(500)
+			h.Push(v)
+			oracle = append(oracle, v)
+		case op == 2:
+			slices.Sort(oracle)
+			if v, err := h.TryPopMin(); err != nil || v != oracle[0] {
+				t.Fatalf("TryPopMin 기대값: %d, 실제값: %d, 에러: %v", oracle[0], v, err)
+			}
+			oracle = oracle[1:]
+		default:
+			slices.Sort(oracle)
+			last := len(oracle) - 1
+			if v, err := h.TryPopMax(); err != nil || v != oracle[last] {
+				t.Fatalf("TryPopMax 기대값: %d, 실제값: %d, 에러: %v", oracle[last], v, err)
+			}
+			oracle = oracle[:last]
+		}
+
+		if h.Size() != len(oracle) {
+			t.Fatalf("크기 기대값: %d, 실제값: %d", len(oracle), h.Size())
+		}
+		if len(oracle) > 0 {
+			if h.PeekMin() != slices.Min(oracle) || h.PeekMax() != slices.Max(oracle) {
+				t.Fatalf("PeekMin/PeekMax 기대값: %d/%d, 실제값: %d/%d",
+					slices.Min(oracle), slices.Max(oracle), h.PeekMin(), h.PeekMax())
+			}
+		}
+	}
+
+	stored := slices.Sorted(h.All())
+	slices.Sort(oracle)
+	if !slices.Equal(stored, oracle) {
+		t.Errorf("All 로 순회한 원소가 올바르지 않습니다. 기대값: %v, 실제값: %v", oracle, stored)
+	}
+}
+
+func TestBoundedMinMaxHeap(t *testing.T) {
+	h := heap.NewBoundedMinMaxHeapFunc(3, intLess)
+
+	for _, v := range []int{5, 1, 9} {
+		if _, dropped := h.Offer(v); dropped {
+			t.Errorf("용량이 남아있는데 %d 를 넣을 때 원소가 버려졌습니다", v)
+		}
+	}
+	if !h.IsFull() || h.Capacity() != 3 {
+		t.Errorf("가득 찬 힙이어야 합니다. 크기: %d, 용량: %d", h.Size(), h.Capacity())
+	}
+
+	// 가장 뒤에 있는 9 가 버려져야 함
+	if v, dropped := h.Offer(3); !dropped || v != 9 {
+		t.Errorf("버려진 원소 기대값: 9, 실제값: %d (%v)", v, dropped)
+	}
+	// 새 원소가 가장 뒤에 있으면 새 원소가 버려짐
+	if v, dropped := h.Offer(7); !dropped || v != 7 {
+		t.Errorf("버려진 원소 기대값: 7, 실제값: %d (%v)", v, dropped)
+	}
+
+	if h.Size() != 3 || h.PeekMin() != 1 || h.PeekMax() != 5 {
+		t.Errorf("남은 원소 크기/Min/Max 기대값: 3/1/5, 실제값: %d/%d/%d", h.Size(), h.PeekMin(), h.PeekMax())
+	}
+}
+
+func TestBoundedMinMaxHeapRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	capacity := 50
+	h := heap.NewBoundedMinMaxHeapFunc(capacity, intLess)
+	values := []int{}
+
+	for i := 0; i < 2000; i++ {
+		v := r.Intn(10000)
+		h.Push(v)
+		values = append(values, v)
+	}
+
+	// 가장 앞서는 capacity 개만 남아있어야 함
+	slices.Sort(values)
+	expected := values[:capacity]
+	actual := []int{}
+	for h.Size() > 0 {
+		actual = append(actual, h.PopMin())
+	}
+	if !slices.Equal(actual, expected) {
+		t.Errorf("남은 원소 기대값: %v, 실제값: %v", expected, actual)
+	}
+}
@@ -0,0 +1,218 @@
+package heap
+
+// owner 는 노드가 어느 힙에 속하는지 나타낸다.
+// Meld 로 합쳐진 힙의 owner 는 합친 힙의 owner 를 가리키므로 (union-find)
+// 노드를 하나하나 옮기지 않고도 소속을 확인할 수 있다.
+type owner struct {
+	parent *owner
+}
+
+func (o *owner) find() *owner {
+	for o.parent != nil {
+		// 경로 압축
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
+
+type PairingNode[T any] struct {
+	value   T
+	child   *PairingNode[T] // 가장 왼쪽 자식
+	sibling *PairingNode[T] // 오른쪽 형제
+	prev    *PairingNode[T] // 가장 왼쪽 자식이면 부모, 아니면 왼쪽 형제
+	owner   *owner
+}
+
+func (n *PairingNode[T]) Value() T {
+	return n.value
+}
+
+// PairingHeap 은 포인터 기반 mergeable 힙이다.
+// Insert, Meld, Peek 은 O(1), Remove 와 DecreaseKey 는 amortized O(log n) 이다.
+// less(a, b) 가 true 이면 a 가 b 보다 먼저 나온다.
+type PairingHeap[T any] struct {
+	root  *PairingNode[T]
+	size  int
+	owner *owner
+	less  func(a T, b T) bool
+}
+
+// GetPriority 가 가장 큰 값이 먼저 나오는 pairing heap 을 만든다.
+func NewPairingHeap[T Prioritized]() *PairingHeap[T] {
+	return NewPairingHeapFunc(MaxPriority[T])
+}
+
+func NewPairingHeapFunc[T any](less func(a T, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{
+		owner: &owner{},
+		less:  less,
+	}
+}
+
+func (h *PairingHeap[T]) Size() int {
+	return h.size
+}
+
+// 두 트리 중 앞서는 루트 아래에 다른 트리를 가장 왼쪽 자식으로 붙이고 새 루트를 반환한다.
+func (h *PairingHeap[T]) link(a *PairingNode[T], b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+
+	return a
+}
+
+// node 의 서브트리를 부모에게서 떼어낸다.
+func (h *PairingHeap[T]) cut(node *PairingNode[T]) {
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+
+	node.prev = nil
+	node.sibling = nil
+}
+
+// 삽입한 원소의 노드를 반환한다. 노드로 나중에 DecreaseKey 할 수 있다.
+func (h *PairingHeap[T]) Insert(value T) *PairingNode[T] {
+	node := &PairingNode[T]{value: value, owner: h.owner}
+	h.root = h.link(h.root, node)
+	h.size++
+	return node
+}
+
+func (h *PairingHeap[T]) Push(value T) {
+	h.Insert(value)
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다.
+func (h *PairingHeap[T]) Peek() T {
+	value, _ := h.TryPeek()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *PairingHeap[T]) TryPeek() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return h.root.value, nil
+}
+
+// 빈 힙이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryRemove 를 사용한다.
+func (h *PairingHeap[T]) Remove() T {
+	value, _ := h.TryRemove()
+	return value
+}
+
+// less 기준으로 가장 앞서는 원소를 꺼낸다. 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *PairingHeap[T]) TryRemove() (T, error) {
+	if h.root == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	root := h.root
+	h.root = h.mergePairs(root.child)
+	if h.root != nil {
+		h.root.prev = nil
+	}
+	h.size--
+
+	root.child = nil
+	root.owner = nil
+	return root.value, nil
+}
+
+// 형제 리스트를 왼쪽부터 두 개씩 합친 뒤 (1st pass) 오른쪽부터 하나로 합친다 (2nd pass).
+// 1st pass 의 결과는 sibling 으로 거꾸로 이어두므로 따로 배열을 할당하지 않는다.
+func (h *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	var pairs *PairingNode[T] // 가장 오른쪽 쌍부터 sibling 으로 이어진다
+	for first != nil {
+		a := first
+		b := a.sibling
+		first = nil
+		if b != nil {
+			first = b.sibling
+			b.sibling = nil
+			b.prev = nil
+		}
+		a.sibling = nil
+		a.prev = nil
+
+		pair := h.link(a, b)
+		pair.sibling = pairs
+		pairs = pair
+	}
+
+	var result *PairingNode[T]
+	for pairs != nil {
+		pair := pairs
+		pairs = pair.sibling
+		pair.sibling = nil
+
+		result = h.link(pair, result)
+	}
+	return result
+}
+
+// node 가 이 힙에 남아있는 원소인지 확인한다.
+func (h *PairingHeap[T]) Contains(node *PairingNode[T]) bool {
+	return node != nil && node.owner != nil && node.owner.find() == h.owner
+}
+
+// node 의 값을 less 기준으로 더 앞서거나 같은 value 로 바꾼다.
+// value 가 기존 값보다 뒤로 가는 값이거나 node 가 이 힙의 원소가 아니면 false 를 반환한다.
+func (h *PairingHeap[T]) DecreaseKey(node *PairingNode[T], value T) bool {
+	if !h.Contains(node) || h.less(node.value, value) {
+		return false
+	}
+
+	node.value = value
+	if node == h.root {
+		return true
+	}
+
+	// 서브트리째 떼어서 루트와 다시 합친다
+	h.cut(node)
+	h.root = h.link(h.root, node)
+	return true
+}
+
+// other 의 모든 원소를 O(1) 에 이 힙으로 옮기고 other 는 비운다.
+// other 의 노드는 이 힙에서 계속 사용할 수 있다.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == nil || other == h || other.root == nil {
+		return
+	}
+
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+
+	other.owner.parent = h.owner
+	other.owner = &owner{}
+	other.root = nil
+	other.size = 0
+}
@@ -0,0 +1,275 @@
+package heap_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	heap "github.com/tmdgusya/go-data-structure/heap"
+)
+
+func intLess(a int, b int) bool {
+	return a < b
+}
+
+// Interface 로 넣고 뺀 결과가 정렬한 결과와 같은지 확인
+func checkAgainstSort(t *testing.T, name string, h heap.Interface[int]) {
+	t.Helper()
+	r := rand.New(rand.NewSource(7))
+
+	oracle := []int{}
+	for step := 0; step < 3000; step++ {
+		if r.Intn(3) == 0 && h.Size() > 0 {
+			slices.Sort(oracle)
+			expected := oracle[0]
+			oracle = oracle[1:]
+
+			if peek := h.Peek(); peek != expected {
+				t.Fatalf("%s: Peek 기대값: %d, 실제값: %d", name, expected, peek)
+			}
+			if v, err := h.TryRemove(); err != nil || v != expected {
+				t.Fatalf("%s: TryRemove 기대값: %d, 실제값: %d, 에러: %v", name, expected, v, err)
+			}
+		} else {
+			v := r.Intn(1000)
+			h.Push(v)
+			oracle = append(oracle, v)
+		}
+
+		if h.Size() != len(oracle) {
+			t.Fatalf("%s: 크기 기대값: %d, 실제값: %d", name, len(oracle), h.Size())
+		}
+	}
+
+	slices.Sort(oracle)
+	for _, expected := range oracle {
+		if v := h.Remove(); v != expected {
+			t.Fatalf("%s: Remove 기대값: %d, 실제값: %d", name, expected, v)
+		}
+	}
+
+	if _, err := h.TryRemove(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("%s: 빈 힙에서 TryRemove 가 ErrEmpty 를 반환하지 않았습니다: %v", name, err)
+	}
+	if _, err := h.TryPeek(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("%s: 빈 힙에서 TryPeek 이 ErrEmpty 를 반환하지 않았습니다: %v", name, err)
+	}
+}
+
+func TestInterfaceImplementations(t *testing.T) {
+	implementations := map[string]heap.Interface[int]{
+		"array":    heap.NewHeapFunc(0, intLess),
+		"4-ary":    heap.NewDaryHeapFunc(4, 0, intLess),
+		"pairing":  heap.NewPairingHeapFunc(intLess),
+		"binomial": heap.NewBinomialHeapFunc(intLess),
+	}
+
+	for name, h := range implementations {
+		t.Run(name, func(t *testing.T) {
+			checkAgainstSort(t, name, h)
+		})
+	}
+}
+
+// AddressableHeap 만으로 Insert, Meld, DecreaseKey 를 섞어 쓴 결과가 정렬한 결과와 같은지 확인
+func checkAddressable[H comparable, S heap.AddressableHeap[int, H, S]](t *testing.T, newHeap func() S) {
+	t.Helper()
+	r := rand.New(rand.NewSource(19))
+
+	a, b := newHeap(), newHeap()
+	values := map[H]int{}
+	handles := []H{}
+	for i := 0; i < 400; i++ {
+		target := a
+		if i%2 == 1 {
+			target = b
+		}
+		v := r.Intn(100000)
+		handle := target.Insert(v)
+		values[handle] = v
+		handles = append(handles, handle)
+	}
+
+	a.Meld(b)
+	if a.Size() != len(handles) || b.Size() != 0 {
+		t.Fatalf("Meld 후 크기 기대값: %d/0, 실제값: %d/%d", len(handles), a.Size(), b.Size())
+	}
+
+	for _, handle := range handles {
+		if !a.Contains(handle) || b.Contains(handle) {
+			t.Fatal("Meld 후 핸들은 합친 힙의 원소여야 합니다")
+		}
+		if r.Intn(2) == 0 {
+			continue
+		}
+		if a.DecreaseKey(handle, values[handle]+1) {
+			t.Fatal("뒤로 가는 값으로의 DecreaseKey 는 실패해야 합니다")
+		}
+		v := values[handle] - r.Intn(50000)
+		if !a.DecreaseKey(handle, v) {
+			t.Fatal("DecreaseKey 가 실패했습니다")
+		}
+		values[handle] = v
+	}
+
+	expected := []int{}
+	for _, v := range values {
+		expected = append(expected, v)
+	}
+	slices.Sort(expected)
+	for _, exp := range expected {
+		if v := a.Remove(); v != exp {
+			t.Fatalf("Remove 기대값: %d, 실제값: %d", exp, v)
+		}
+	}
+
+	for _, handle := range handles {
+		if a.Contains(handle) || a.DecreaseKey(handle, 0) {
+			t.Fatal("꺼낸 원소의 핸들은 더 이상 유효하지 않아야 합니다")
+		}
+	}
+}
+
+func TestAddressableHeapImplementations(t *testing.T) {
+	t.Run("array", func(t *testing.T) {
+		checkAddressable(t, func() *heap.Heap[int] { return heap.NewHeapFunc(0, intLess) })
+	})
+	t.Run("4-ary", func(t *testing.T) {
+		checkAddressable(t, func() *heap.Heap[int] { return heap.NewDaryHeapFunc(4, 0, intLess) })
+	})
+	t.Run("pairing", func(t *testing.T) {
+		checkAddressable(t, func() *heap.PairingHeap[int] { return heap.NewPairingHeapFunc(intLess) })
+	})
+	t.Run("binomial", func(t *testing.T) {
+		checkAddressable(t, func() *heap.BinomialHeap[int] { return heap.NewBinomialHeapFunc(intLess) })
+	})
+}
+
+func TestPairingHeapMaxPriority(t *testing.T) {
+	h := heap.NewPairingHeap[TaskRecord]()
+	h.Insert(TaskRecord{Priority: 3, TaskName: "low"})
+	h.Insert(TaskRecord{Priority: 10, TaskName: "critical"})
+	h.Insert(TaskRecord{Priority: 5, TaskName: "medium"})
+
+	for _, exp := range []string{"critical", "medium", "low"} {
+		if task := h.Remove(); task.TaskName != exp {
+			t.Errorf("Remove 기대값: %s, 실제값: %s", exp, task.TaskName)
+		}
+	}
+}
+
+func TestPairingHeapRemoveAllocs(t *testing.T) {
+	h := heap.NewPairingHeapFunc(intLess)
+	for i := 1000; i > 0; i-- {
+		h.Push(i)
+	}
+
+	// 루트를 꺼내고 자식들을 합치는 데 할당이 없어야 함
+	prev := 0
+	allocs := testing.AllocsPerRun(500, func() {
+		v := h.Remove()
+		if v < prev {
+			t.Fatalf("Remove 순서가 올바르지 않습니다: %d 다음에 %d", prev, v)
+		}
+		prev = v
+	})
+	if allocs != 0 {
+		t.Errorf("TryRemove 가 %v 번 할당했습니다", allocs)
+	}
+}
+
+func TestPairingHeapDecreaseKey(t *testing.T) {
+	h := heap.NewPairingHeapFunc(intLess)
+
+	nodes := []*heap.PairingNode[int]{}
+	for _, v := range []int{50, 30, 40, 10, 20} {
+		nodes = append(nodes, h.Insert(v))
+	}
+	h.Remove() // 10 을 빼서 트리 구조를 만든다
+
+	if !h.DecreaseKey(nodes[0], 5) {
+		t.Error("DecreaseKey 가 실패했습니다")
+	}
+	if h.Peek() != 5 || nodes[0].Value() != 5 {
+		t.Errorf("DecreaseKey 후 Peek 기대값: 5, 실제값: %d", h.Peek())
+	}
+
+	// 값이 뒤로 가는 변경은 허용하지 않음
+	if h.DecreaseKey(nodes[1], 35) {
+		t.Error("값을 늘리는 DecreaseKey 가 성공했습니다")
+	}
+	// 이미 빠진 노드
+	if h.Contains(nodes[3]) || h.DecreaseKey(nodes[3], 1) {
+		t.Error("제거된 노드로 DecreaseKey 가 성공했습니다")
+	}
+
+	if got := []int{h.Remove(), h.Remove(), h.Remove(), h.Remove()}; !slices.Equal(got, []int{5, 20, 30, 40}) {
+		t.Errorf("DecreaseKey 후 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+}
+
+func TestPairingHeapRandomDecreaseKey(t *testing.T) {
+	h := heap.NewPairingHeapFunc(intLess)
+	r := rand.New(rand.NewSource(3))
+
+	nodes := []*heap.PairingNode[int]{}
+	for i := 0; i < 500; i++ {
+		nodes = append(nodes, h.Insert(r.Intn(100000)))
+	}
+	for i := 0; i < 100; i++ {
+		h.Remove()
+	}
+
+	expected := []int{}
+	for _, node := range nodes {
+		if !h.Contains(node) {
+			continue
+		}
+		v := node.Value() - r.Intn(50000)
+		if !h.DecreaseKey(node, v) {
+			t.Fatal("DecreaseKey 가 실패했습니다")
+		}
+		expected = append(expected, v)
+	}
+
+	slices.Sort(expected)
+	for _, exp := range expected {
+		if v := h.Remove(); v != exp {
+			t.Fatalf("Remove 기대값: %d, 실제값: %d", exp, v)
+		}
+	}
+}
+
+func TestPairingHeapMeld(t *testing.T) {
+	a := heap.NewPairingHeapFunc(intLess)
+	b := heap.NewPairingHeapFunc(intLess)
+	a.Push(5)
+	a.Push(1)
+	node := b.Insert(7)
+	b.Push(3)
+
+	a.Meld(b)
+
+	if a.Size() != 4 || b.Size() != 0 {
+		t.Errorf("Meld 후 크기가 올바르지 않습니다. a: %d, b: %d", a.Size(), b.Size())
+	}
+
+	// b 의 노드는 a 에서 계속 사용할 수 있어야 함
+	if !a.Contains(node) || b.Contains(node) {
+		t.Error("Meld 후 노드의 소속이 옮겨지지 않았습니다")
+	}
+	if !a.DecreaseKey(node, 0) || a.Peek() != 0 {
+		t.Error("Meld 후 옮겨진 노드로 DecreaseKey 가 올바르게 동작하지 않았습니다")
+	}
+
+	// 비워진 b 는 다시 사용할 수 있고, 그 뒤에 다시 합쳐도 됨
+	b.Push(2)
+	a.Meld(b)
+	a.Meld(a)
+	a.Meld(nil)
+
+	if got := []int{a.Remove(), a.Remove(), a.Remove(), a.Remove(), a.Remove()}; !slices.Equal(got, []int{0, 1, 2, 3, 5}) {
+		t.Errorf("Meld 후 Remove 순서가 올바르지 않습니다: %v", got)
+	}
+}
@@ -0,0 +1,170 @@
+package heap
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tmdgusya/go-data-structure/internal/notify"
+)
+
+// 닫힌 큐에 값을 넣으려 하거나, 닫힌 뒤 남은 값을 모두 꺼낸 큐에서 값을 꺼내려 할 때 반환된다.
+var ErrClosed = errors.New("heap: closed priority queue")
+
+// Clock 은 delay 모드의 PriorityQueue 가 현재 시각을 얻고 기다리는 데 쓴다.
+// 테스트에서는 직접 시간을 움직이는 구현을 넣을 수 있다.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// PriorityQueue 는 여러 goroutine 이 함께 쓰는 Heap 이다.
+// Pop 은 꺼낼 원소가 없으면 ctx 가 끝나거나 원소가 들어올 때까지 기다린다.
+// Close 후에는 새 원소를 받지 않지만 남아있는 원소는 Pop 으로 모두 꺼낼 수 있다.
+// 반드시 NewPriorityQueue 나 NewDelayQueue 로 생성해야 한다.
+type PriorityQueue[T any] struct {
+	mu       sync.Mutex
+	items    *Heap[T]
+	closed   bool
+	changed  notify.Signal // Push 나 Close 로 상태가 바뀌면 기다리는 Pop 을 깨운다
+	ready_at func(T) time.Time
+	clock    Clock
+}
+
+// 큐가 처음 가지는 용량. 이만큼은 늘리거나 줄이는 할당 없이 넣고 뺄 수 있다.
+const priorityQueueSize = 16
+
+// less 기준으로 가장 앞서는 원소부터 꺼내는 큐를 만든다.
+func NewPriorityQueue[T any](less func(a T, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		items: NewHeapFunc(priorityQueueSize, less),
+	}
+}
+
+// ready_at 이 가리키는 시각이 된 원소만 꺼낼 수 있는 delay queue 를 만든다.
+// 원소는 ready_at 이 이른 순서로 나온다. clock 이 nil 이면 실제 시계를 쓴다.
+func NewDelayQueue[T any](ready_at func(T) time.Time, clock Clock) *PriorityQueue[T] {
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	q := NewPriorityQueue(func(a T, b T) bool {
+		return ready_at(a).Before(ready_at(b))
+	})
+	q.ready_at = ready_at
+	q.clock = clock
+	return q
+}
+
+func (q *PriorityQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Size()
+}
+
+// 닫힌 큐이면 ErrClosed 를 반환한다.
+func (q *PriorityQueue[T]) Push(value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+
+	q.items.Push(value)
+	q.changed.Broadcast()
+	return nil
+}
+
+// 꺼낼 수 있는 원소가 없으면 기다린다.
+// 닫힌 큐가 비어 있으면 ErrClosed 를, ctx 가 먼저 끝나면 ctx.Err() 를 반환한다.
+func (q *PriorityQueue[T]) Pop(ctx context.Context) (T, error) {
+	var zero T
+
+	q.mu.Lock()
+	for {
+		value, wait_for, err := q.tryPopLocked()
+		if !errors.Is(err, ErrEmpty) {
+			q.mu.Unlock()
+			return value, err
+		}
+
+		changed := q.changed.Wait()
+		q.mu.Unlock()
+
+		// delay 모드에서 아직 때가 안 된 원소가 있으면 그 시각까지만 기다린다
+		var timer <-chan time.Time
+		if wait_for > 0 {
+			timer = q.clock.After(wait_for)
+		}
+
+		select {
+		case <-changed:
+		case <-timer:
+		case <-ctx.Done():
+			q.mu.Lock()
+			q.changed.Cancel(changed)
+			q.mu.Unlock()
+			return zero, ctx.Err()
+		}
+
+		// 타이머로 깨어났으면 아직 대기 중으로 세어져 있다
+		q.mu.Lock()
+		q.changed.Cancel(changed)
+	}
+}
+
+// 기다리지 않는다. 꺼낼 수 있는 원소가 없으면 ErrEmpty,
+// 닫힌 뒤 비어 있으면 ErrClosed 를 반환한다.
+func (q *PriorityQueue[T]) TryPop() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	value, _, err := q.tryPopLocked()
+	return value, err
+}
+
+// ErrEmpty 와 함께 반환하는 duration 은 delay 모드에서 가장 이른 원소가 꺼낼 수 있게 될 때까지 남은 시간이다.
+func (q *PriorityQueue[T]) tryPopLocked() (T, time.Duration, error) {
+	top, err := q.items.TryPeek()
+	if err != nil {
+		if q.closed {
+			return top, 0, ErrClosed
+		}
+		return top, 0, ErrEmpty
+	}
+
+	if q.ready_at != nil {
+		if wait_for := q.ready_at(top).Sub(q.clock.Now()); wait_for > 0 {
+			var zero T
+			return zero, wait_for, ErrEmpty
+		}
+	}
+
+	return q.items.Remove(), 0, nil
+}
+
+// 더 이상 원소를 받지 않도록 닫고 기다리는 goroutine 을 모두 깨운다. 여러 번 호출해도 된다.
+// delay 모드에서 아직 때가 안 된 원소는 때가 되면 Pop 으로 꺼낼 수 있다.
+func (q *PriorityQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	q.changed.Broadcast()
+}
@@ -0,0 +1,330 @@
+package heap_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	heap "github.com/tmdgusya/go-data-structure/heap"
+)
+
+// 테스트에서 직접 시간을 움직이는 Clock
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+func (c *fakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	remaining := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.at.After(c.now) {
+			w.ch <- c.now
+		} else {
+			remaining = append(remaining, w)
+		}
+	}
+	c.waiters = remaining
+}
+
+// 조건이 만족될 때까지 잠깐씩 기다린다
+func eventually(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("조건이 만족되지 않았습니다")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPriorityQueueTryPop(t *testing.T) {
+	q := heap.NewPriorityQueue(intLess)
+
+	if _, err := q.TryPop(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("빈 큐에서 TryPop 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+
+	for _, v := range []int{5, 1, 3} {
+		q.Push(v)
+	}
+
+	for _, exp := range []int{1, 3, 5} {
+		if v, err := q.TryPop(); err != nil || v != exp {
+			t.Errorf("TryPop 기대값: %d, 실제값: %d, 에러: %v", exp, v, err)
+		}
+	}
+}
+
+func TestPriorityQueueNoWaiterAllocs(t *testing.T) {
+	q := heap.NewPriorityQueue(intLess)
+
+	// 취소된 Pop 이 남긴 대기 표시 때문에 이후 Push 가 채널을 새로 만들면 안 됨
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	q.Pop(ctx)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		q.Push(1)
+		q.TryPop()
+	})
+	if allocs != 0 {
+		t.Errorf("기다리는 goroutine 이 없는데 Push/TryPop 이 %v 번 할당했습니다", allocs)
+	}
+}
+
+func TestPriorityQueuePopWaits(t *testing.T) {
+	q := heap.NewPriorityQueue(intLess)
+
+	done := make(chan int)
+	go func() {
+		v, err := q.Pop(context.Background())
+		if err != nil {
+			t.Errorf("Pop 이 실패했습니다: %v", err)
+		}
+		done <- v
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	q.Push(42)
+
+	select {
+	case v := <-done:
+		if v != 42 {
+			t.Errorf("Pop 기대값: 42, 실제값: %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Pop 이 깨어나지 않았습니다")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.Pop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Pop 이 ctx 에러를 반환하지 않았습니다: %v", err)
+	}
+}
+
+func TestPriorityQueueClose(t *testing.T) {
+	q := heap.NewPriorityQueue(intLess)
+	q.Push(2)
+	q.Push(1)
+	q.Close()
+	q.Close()
+
+	if err := q.Push(3); !errors.Is(err, heap.ErrClosed) {
+		t.Errorf("닫힌 큐에서 Push 가 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+
+	// 남아있는 원소는 꺼낼 수 있어야 함
+	for _, exp := range []int{1, 2} {
+		if v, err := q.Pop(context.Background()); err != nil || v != exp {
+			t.Errorf("닫힌 큐에서 Pop 기대값: %d, 실제값: %d, 에러: %v", exp, v, err)
+		}
+	}
+	if _, err := q.Pop(context.Background()); !errors.Is(err, heap.ErrClosed) {
+		t.Errorf("비워진 닫힌 큐에서 Pop 이 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := q.TryPop(); !errors.Is(err, heap.ErrClosed) {
+		t.Errorf("비워진 닫힌 큐에서 TryPop 이 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+}
+
+func TestPriorityQueueConcurrent(t *testing.T) {
+	q := heap.NewPriorityQueue(intLess)
+	producers, consumers, per_producer := 4, 4, 500
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(p int) {
+			defer produced.Done()
+			for i := 0; i < per_producer; i++ {
+				q.Push(p*per_producer + i)
+			}
+		}(p)
+	}
+
+	results := make(chan int, producers*per_producer)
+	var consumed sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				v, err := q.Pop(context.Background())
+				if errors.Is(err, heap.ErrClosed) {
+					return
+				}
+				results <- v
+			}
+		}()
+	}
+
+	produced.Wait()
+	q.Close()
+	consumed.Wait()
+	close(results)
+
+	seen := make([]bool, producers*per_producer)
+	count := 0
+	for v := range results {
+		if seen[v] {
+			t.Fatalf("값 %d 를 두 번 꺼냈습니다", v)
+		}
+		seen[v] = true
+		count++
+	}
+	if count != producers*per_producer {
+		t.Errorf("꺼낸 값의 개수가 올바르지 않습니다. 기대값: %d, 실제값: %d", producers*per_producer, count)
+	}
+}
+
+type delayedJob struct {
+	name  string
+	ready time.Time
+}
+
+func TestDelayQueue(t *testing.T) {
+	clock := newFakeClock()
+	q := heap.NewDelayQueue(func(j delayedJob) time.Time { return j.ready }, clock)
+	start := clock.Now()
+
+	q.Push(delayedJob{name: "later", ready: start.Add(2 * time.Minute)})
+	q.Push(delayedJob{name: "sooner", ready: start.Add(time.Minute)})
+
+	if _, err := q.TryPop(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("때가 안 된 원소를 TryPop 이 꺼냈습니다: %v", err)
+	}
+
+	done := make(chan string)
+	go func() {
+		job, err := q.Pop(context.Background())
+		if err != nil {
+			t.Errorf("Pop 이 실패했습니다: %v", err)
+		}
+		done <- job.name
+	}()
+
+	// Pop 이 가장 이른 원소의 시각까지 기다리기 시작할 때까지 대기
+	eventually(t, func() bool { return clock.Waiters() == 1 })
+
+	clock.Advance(30 * time.Second)
+	select {
+	case name := <-done:
+		t.Fatalf("때가 되기 전에 %s 가 나왔습니다", name)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	clock.Advance(30 * time.Second)
+	select {
+	case name := <-done:
+		if name != "sooner" {
+			t.Errorf("Pop 기대값: sooner, 실제값: %s", name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("때가 된 원소가 나오지 않았습니다")
+	}
+
+	clock.Advance(time.Minute)
+	if job, err := q.TryPop(); err != nil || job.name != "later" {
+		t.Errorf("TryPop 기대값: later, 실제값: %s, 에러: %v", job.name, err)
+	}
+}
+
+func TestDelayQueueEarlierPushWakesPop(t *testing.T) {
+	clock := newFakeClock()
+	q := heap.NewDelayQueue(func(j delayedJob) time.Time { return j.ready }, clock)
+	start := clock.Now()
+
+	q.Push(delayedJob{name: "hour", ready: start.Add(time.Hour)})
+
+	done := make(chan string)
+	go func() {
+		job, _ := q.Pop(context.Background())
+		done <- job.name
+	}()
+	eventually(t, func() bool { return clock.Waiters() == 1 })
+
+	// 이미 때가 된 원소를 넣으면 기다리던 Pop 이 바로 꺼내야 함
+	q.Push(delayedJob{name: "now", ready: start})
+
+	select {
+	case name := <-done:
+		if name != "now" {
+			t.Errorf("Pop 기대값: now, 실제값: %s", name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("더 이른 원소가 들어왔는데 Pop 이 깨어나지 않았습니다")
+	}
+
+	// 닫혀도 남은 원소는 때가 되면 꺼낼 수 있음
+	q.Close()
+	if _, err := q.TryPop(); !errors.Is(err, heap.ErrEmpty) {
+		t.Errorf("닫힌 큐에서 때가 안 된 원소를 TryPop 이 꺼냈습니다: %v", err)
+	}
+	clock.Advance(time.Hour)
+	if job, err := q.Pop(context.Background()); err != nil || job.name != "hour" {
+		t.Errorf("닫힌 큐에서 Pop 기대값: hour, 실제값: %s, 에러: %v", job.name, err)
+	}
+	if _, err := q.TryPop(); !errors.Is(err, heap.ErrClosed) {
+		t.Errorf("비워진 닫힌 큐에서 TryPop 이 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+}
+
+func TestDelayQueueRealClock(t *testing.T) {
+	q := heap.NewDelayQueue(func(j delayedJob) time.Time { return j.ready }, nil)
+	q.Push(delayedJob{name: "soon", ready: time.Now().Add(20 * time.Millisecond)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	job, err := q.Pop(ctx)
+	if err != nil || job.name != "soon" {
+		t.Fatalf("Pop 기대값: soon, 실제값: %s, 에러: %v", job.name, err)
+	}
+	if time.Since(start) < 10*time.Millisecond {
+		t.Error("때가 되기 전에 원소가 나왔습니다")
+	}
+}
@@ -0,0 +1,148 @@
+package heap
+
+import (
+	"iter"
+	"slices"
+)
+
+// BoundedHeap 은 끝없이 들어오는 원소 중 less 기준으로 가장 앞서는 k 개만 유지한다.
+// 내부적으로 순서를 뒤집은 힙을 써서 루트에 유지 중인 원소 중 가장 뒤에 있는 원소(다음에 밀려날 원소)를 둔다.
+// 원소 하나를 넣는 데 O(log k) 이다.
+type BoundedHeap[T any] struct {
+	items *Heap[T]
+	k     int
+	less  func(a T, b T) bool
+}
+
+// k 가 0 이하이면 아무 원소도 유지하지 않는다.
+func NewBoundedHeap[T any](k int, less func(a T, b T) bool) *BoundedHeap[T] {
+	k = max(k, 0)
+
+	return &BoundedHeap[T]{
+		items: NewHeapFunc(k, func(a T, b T) bool {
+			return less(b, a)
+		}),
+		k:    k,
+		less: less,
+	}
+}
+
+func (h *BoundedHeap[T]) Size() int {
+	return h.items.Size()
+}
+
+// 유지할 수 있는 최대 원소 수
+func (h *BoundedHeap[T]) Limit() int {
+	return h.k
+}
+
+func (h *BoundedHeap[T]) Push(value T) {
+	h.Offer(value)
+}
+
+// value 를 넣는다. 이미 k 개를 유지하고 있으면 가장 뒤에 있는 원소(value 자신일 수도 있다)를 버리고
+// 버린 원소와 true 를 반환한다.
+func (h *BoundedHeap[T]) Offer(value T) (T, bool) {
+	if h.items.Size() < h.k {
+		h.items.Push(value)
+
+		var zero T
+		return zero, false
+	}
+
+	if h.k == 0 || !h.less(value, h.items.Peek()) {
+		return value, true
+	}
+	return h.items.Replace(value), true
+}
+
+// 유지 중인 원소 중 less 기준으로 가장 뒤에 있는 원소. 빈 힙이면 T 의 zero value 를 반환한다.
+func (h *BoundedHeap[T]) Peek() T {
+	value, _ := h.TryPeek()
+	return value
+}
+
+// 빈 힙이면 ErrEmpty 를 반환한다.
+func (h *BoundedHeap[T]) TryPeek() (T, error) {
+	return h.items.TryPeek()
+}
+
+// 유지 중인 원소를 less 순서로 정렬해서 반환한다. 힙은 그대로 둔다.
+func (h *BoundedHeap[T]) Sorted() []T {
+	sorted := NewHeapFromFunc(slices.Collect(h.items.All()), h.less)
+	return slices.Collect(sorted.Drain())
+}
+
+// 내부 배열 순서로 순회한다. less 순서가 아니다.
+func (h *BoundedHeap[T]) All() iter.Seq[T] {
+	return h.items.All()
+}
+
+func (h *BoundedHeap[T]) Clear() {
+	h.items.Clear()
+}
+
+// seq 에서 less 기준으로 가장 앞서는 k 개를 less 순서로 반환한다.
+// 전체를 정렬하는 O(n log n) 대신 O(n log k) 이다.
+func TopK[T any](seq iter.Seq[T], k int, less func(a T, b T) bool) []T {
+	best := NewBoundedHeap(k, less)
+	for value := range seq {
+		best.Push(value)
+	}
+	return best.Sorted()
+}
+
+// seq 에서 less 기준으로 가장 뒤에 있는 k 개를 가장 뒤에 있는 것부터 반환한다.
+func BottomK[T any](seq iter.Seq[T], k int, less func(a T, b T) bool) []T {
+	return TopK(seq, k, func(a T, b T) bool {
+		return less(b, a)
+	})
+}
+
+// 각 seqs 에서 하나씩 꺼낸 값 중 가장 앞서는 값을 찾는 데 쓴다.
+type mergeItem[T any] struct {
+	value  T
+	source int
+}
+
+// less 순서로 정렬된 seqs 를 하나의 정렬된 순회로 합친다. (k-way merge)
+// 원소 하나를 내보내는 데 O(log k) 이다. 같은 값이면 앞쪽 seq 의 원소가 먼저 나온다.
+func MergeSorted[T any](less func(a T, b T) bool, seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), len(seqs))
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			nexts[i] = next
+		}
+
+		items := NewHeapFunc(len(seqs), func(a mergeItem[T], b mergeItem[T]) bool {
+			if less(a.value, b.value) {
+				return true
+			}
+			if less(b.value, a.value) {
+				return false
+			}
+			return a.source < b.source
+		})
+		for i, next := range nexts {
+			if value, ok := next(); ok {
+				items.Push(mergeItem[T]{value: value, source: i})
+			}
+		}
+
+		for items.Size() > 0 {
+			item := items.Peek()
+			if !yield(item.value) {
+				return
+			}
+
+			// 꺼낸 seq 의 다음 값으로 루트를 바꾸거나, 다 읽었으면 뺀다
+			if value, ok := nexts[item.source](); ok {
+				items.Replace(mergeItem[T]{value: value, source: item.source})
+			} else {
+				items.Remove()
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package heap_test
+
+import (
+	"iter"
+	"math/rand"
+	"slices"
+	"testing"
+
+	heap "github.com/tmdgusya/go-data-structure/heap"
+)
+
+func TestTopKAgainstSort(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	for _, n := range []int{0, 1, 10, 500} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = r.Intn(100)
+		}
+		sorted := slices.Sorted(slices.Values(values))
+
+		for _, k := range []int{0, 1, 5, n, n + 3} {
+			expected := sorted[:min(k, n)]
+			if actual := heap.TopK(slices.Values(values), k, intLess); !slices.Equal(actual, expected) {
+				t.Errorf("n=%d k=%d TopK 기대값: %v, 실제값: %v", n, k, expected, actual)
+			}
+
+			expected = slices.Clone(sorted[n-min(k, n):])
+			slices.Reverse(expected)
+			if actual := heap.BottomK(slices.Values(values), k, intLess); !slices.Equal(actual, expected) {
+				t.Errorf("n=%d k=%d BottomK 기대값: %v, 실제값: %v", n, k, expected, actual)
+			}
+		}
+	}
+}
+
+func TestBoundedHeapStream(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	h := heap.NewBoundedHeap(10, intLess)
+	seen := []int{}
+
+	for i := 0; i < 1000; i++ {
+		v := r.Intn(100000)
+		h.Push(v)
+		seen = append(seen, v)
+
+		slices.Sort(seen)
+		expected := seen[:min(10, len(seen))]
+		if actual := h.Sorted(); !slices.Equal(actual, expected) {
+			t.Fatalf("%d 번째 원소 후 유지 중인 원소 기대값: %v, 실제값: %v", i, expected, actual)
+		}
+		if h.Peek() != expected[len(expected)-1] {
+			t.Fatalf("Peek 기대값: %d, 실제값: %d", expected[len(expected)-1], h.Peek())
+		}
+	}
+
+	if h.Size() != 10 || h.Limit() != 10 {
+		t.Errorf("크기/Limit 기대값: 10/10, 실제값: %d/%d", h.Size(), h.Limit())
+	}
+}
+
+func TestBoundedHeapOffer(t *testing.T) {
+	h := heap.NewBoundedHeap(2, intLess)
+
+	h.Push(5)
+	h.Push(3)
+	if v, dropped := h.Offer(1); !dropped || v != 5 {
+		t.Errorf("버려진 원소 기대값: 5, 실제값: %d (%v)", v, dropped)
+	}
+	if v, dropped := h.Offer(4); !dropped || v != 4 {
+		t.Errorf("버려진 원소 기대값: 4, 실제값: %d (%v)", v, dropped)
+	}
+
+	empty := heap.NewBoundedHeap(0, intLess)
+	if v, dropped := empty.Offer(1); !dropped || v != 1 || empty.Size() != 0 {
+		t.Errorf("k 가 0 이면 넣은 원소가 바로 버려져야 합니다. 실제값: %d (%v), 크기: %d", v, dropped, empty.Size())
+	}
+}
+
+func TestMergeSortedAgainstSort(t *testing.T) {
+	r := rand.New(rand.NewSource(13))
+
+	lists := [][]int{}
+	all := []int{}
+	for i := 0; i < 6; i++ {
+		list := make([]int, r.Intn(40))
+		for j := range list {
+			list[j] = r.Intn(50)
+		}
+		slices.Sort(list)
+		lists = append(lists, list)
+		all = append(all, list...)
+	}
+	lists = append(lists, nil)
+
+	seqs := []iter.Seq[int]{}
+	for _, list := range lists {
+		seqs = append(seqs, slices.Values(list))
+	}
+
+	slices.Sort(all)
+	if actual := slices.Collect(heap.MergeSorted(intLess, seqs...)); !slices.Equal(actual, all) {
+		t.Errorf("MergeSorted 기대값: %v, 실제값: %v", all, actual)
+	}
+
+	if actual := slices.Collect(heap.MergeSorted(intLess)); len(actual) != 0 {
+		t.Errorf("입력이 없으면 빈 순회여야 합니다. 실제값: %v", actual)
+	}
+}
+
+func TestMergeSortedStableAndEarlyStop(t *testing.T) {
+	type entry struct {
+		key    int
+		source string
+	}
+	byKey := func(a entry, b entry) bool { return a.key < b.key }
+
+	a := []entry{{1, "a"}, {2, "a"}}
+	b := []entry{{1, "b"}, {2, "b"}, {3, "b"}}
+
+	actual := []entry{}
+	for e := range heap.MergeSorted(byKey, slices.Values(a), slices.Values(b)) {
+		actual = append(actual, e)
+		if len(actual) == 3 {
+			break
+		}
+	}
+
+	expected := []entry{{1, "a"}, {1, "b"}, {2, "a"}}
+	if !slices.Equal(actual, expected) {
+		t.Errorf("MergeSorted 기대값: %v, 실제값: %v", expected, actual)
+	}
+}
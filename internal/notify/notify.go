@@ -0,0 +1,38 @@
+// notify 는 mutex 로 보호되는 상태가 바뀌기를 기다리는 goroutine 들을 깨우는 채널 기반 알림을 제공한다.
+// sync.Cond 와 달리 채널이므로 ctx.Done() 과 함께 select 할 수 있다.
+package notify
+
+// Signal 의 모든 메서드는 보호하는 상태와 같은 mutex 를 잡은 상태에서 호출해야 한다.
+// zero value 로 바로 사용할 수 있다.
+type Signal struct {
+	ch      chan struct{}
+	waiting int // ch 를 기다리고 있는 goroutine 수
+}
+
+// 다음 Broadcast 때 닫히는 채널을 돌려준다.
+// 채널이 닫히기 전에 기다리기를 그만두면 (ctx 취소 등) 반드시 Cancel 을 호출한다.
+func (s *Signal) Wait() <-chan struct{} {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	s.waiting++
+	return s.ch
+}
+
+// Wait 로 받은 ch 를 더 이상 기다리지 않는다.
+func (s *Signal) Cancel(ch <-chan struct{}) {
+	if s.ch != nil && (<-chan struct{})(s.ch) == ch {
+		s.waiting--
+	}
+}
+
+// 기다리는 goroutine 을 모두 깨운다. 기다리는 goroutine 이 없으면 아무것도 하지 않으므로 할당도 없다.
+func (s *Signal) Broadcast() {
+	if s.waiting == 0 {
+		return
+	}
+
+	close(s.ch)
+	s.ch = nil
+	s.waiting = 0
+}
@@ -0,0 +1,54 @@
+package notify
+
+import "testing"
+
+func TestBroadcastWakesWaiters(t *testing.T) {
+	var s Signal
+
+	a := s.Wait()
+	b := s.Wait()
+	if a != b {
+		t.Fatal("같은 차례에 기다리는 goroutine 은 같은 채널을 받아야 합니다")
+	}
+
+	s.Broadcast()
+	for _, ch := range []<-chan struct{}{a, b} {
+		select {
+		case <-ch:
+		default:
+			t.Fatal("Broadcast 가 채널을 닫지 않았습니다")
+		}
+	}
+
+	if next := s.Wait(); next == a {
+		t.Error("Broadcast 후의 Wait 는 새 채널을 돌려줘야 합니다")
+	}
+}
+
+func TestBroadcastWithoutWaiters(t *testing.T) {
+	var s Signal
+
+	ch := s.Wait()
+	s.Cancel(ch)
+
+	allocs := testing.AllocsPerRun(100, s.Broadcast)
+	if allocs != 0 {
+		t.Errorf("기다리는 goroutine 이 없는데 Broadcast 가 %v 번 할당했습니다", allocs)
+	}
+
+	select {
+	case <-ch:
+		t.Error("기다리는 goroutine 이 없으면 Broadcast 가 채널을 닫지 않아야 합니다")
+	default:
+	}
+
+	// 지난 차례의 채널로 Cancel 해도 대기 수가 바뀌지 않아야 함
+	s.Cancel(make(chan struct{}))
+	ch = s.Wait()
+	s.Broadcast()
+	select {
+	case <-ch:
+	default:
+		t.Error("Broadcast 가 남은 goroutine 을 깨우지 않았습니다")
+	}
+}
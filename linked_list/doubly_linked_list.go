@@ -0,0 +1,211 @@
+package linked_list
+
+import "iter"
+
+type DoublyLinkedListNode[T any] struct {
+	Value T
+	next  *DoublyLinkedListNode[T]
+	prev  *DoublyLinkedListNode[T]
+	list  *DoublyLinkedList[T]
+}
+
+// 리스트의 마지막 노드이거나 리스트에서 제거된 노드이면 nil 을 반환한다.
+func (n *DoublyLinkedListNode[T]) Next() *DoublyLinkedListNode[T] {
+	if n.list == nil || n.next == &n.list.sentinel {
+		return nil
+	}
+	return n.next
+}
+
+// 리스트의 첫 노드이거나 리스트에서 제거된 노드이면 nil 을 반환한다.
+func (n *DoublyLinkedListNode[T]) Prev() *DoublyLinkedListNode[T] {
+	if n.list == nil || n.prev == &n.list.sentinel {
+		return nil
+	}
+	return n.prev
+}
+
+// DoublyLinkedList 는 sentinel 노드를 이용한 원형 양방향 연결 리스트이다.
+// sentinel.next 가 첫 노드, sentinel.prev 가 마지막 노드이며 zero value 로 바로 사용할 수 있다.
+type DoublyLinkedList[T any] struct {
+	sentinel DoublyLinkedListNode[T]
+	length   int
+}
+
+func NewDoublyLinkedList[T any](values ...T) *DoublyLinkedList[T] {
+	l := &DoublyLinkedList[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+func (l *DoublyLinkedList[T]) lazyInit() {
+	if l.sentinel.next == nil {
+		l.sentinel.next = &l.sentinel
+		l.sentinel.prev = &l.sentinel
+	}
+}
+
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.length
+}
+
+func (l *DoublyLinkedList[T]) Front() *DoublyLinkedListNode[T] {
+	if l.length == 0 {
+		return nil
+	}
+	return l.sentinel.next
+}
+
+func (l *DoublyLinkedList[T]) Back() *DoublyLinkedListNode[T] {
+	if l.length == 0 {
+		return nil
+	}
+	return l.sentinel.prev
+}
+
+// node 를 at 바로 뒤에 연결한다.
+func (l *DoublyLinkedList[T]) link(node *DoublyLinkedListNode[T], at *DoublyLinkedListNode[T]) *DoublyLinkedListNode[T] {
+	node.prev = at
+	node.next = at.next
+	at.next.prev = node
+	at.next = node
+	node.list = l
+	l.length++
+
+	return node
+}
+
+func (l *DoublyLinkedList[T]) unlink(node *DoublyLinkedListNode[T]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.next = nil
+	node.prev = nil
+	node.list = nil
+	l.length--
+}
+
+// node 를 at 바로 뒤로 옮긴다.
+func (l *DoublyLinkedList[T]) move(node *DoublyLinkedListNode[T], at *DoublyLinkedListNode[T]) {
+	if node == at {
+		return
+	}
+
+	node.prev.next = node.next
+	node.next.prev = node.prev
+
+	node.prev = at
+	node.next = at.next
+	at.next.prev = node
+	at.next = node
+}
+
+func (l *DoublyLinkedList[T]) PushFront(value T) *DoublyLinkedListNode[T] {
+	l.lazyInit()
+	return l.link(&DoublyLinkedListNode[T]{Value: value}, &l.sentinel)
+}
+
+func (l *DoublyLinkedList[T]) PushBack(value T) *DoublyLinkedListNode[T] {
+	l.lazyInit()
+	return l.link(&DoublyLinkedListNode[T]{Value: value}, l.sentinel.prev)
+}
+
+// 빈 리스트이면 zero value 와 false 를 반환한다.
+func (l *DoublyLinkedList[T]) PopFront() (T, bool) {
+	node := l.Front()
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+
+	l.unlink(node)
+	return node.Value, true
+}
+
+// 빈 리스트이면 zero value 와 false 를 반환한다.
+func (l *DoublyLinkedList[T]) PopBack() (T, bool) {
+	node := l.Back()
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+
+	l.unlink(node)
+	return node.Value, true
+}
+
+// mark 가 이 리스트의 노드가 아니면 아무것도 하지 않고 nil 을 반환한다.
+func (l *DoublyLinkedList[T]) InsertBefore(mark *DoublyLinkedListNode[T], value T) *DoublyLinkedListNode[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.link(&DoublyLinkedListNode[T]{Value: value}, mark.prev)
+}
+
+// mark 가 이 리스트의 노드가 아니면 아무것도 하지 않고 nil 을 반환한다.
+func (l *DoublyLinkedList[T]) InsertAfter(mark *DoublyLinkedListNode[T], value T) *DoublyLinkedListNode[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.link(&DoublyLinkedListNode[T]{Value: value}, mark)
+}
+
+// O(1) 에 node 를 리스트에서 떼어낸다. node 가 이 리스트의 노드가 아니면 false 를 반환한다.
+func (l *DoublyLinkedList[T]) Remove(node *DoublyLinkedListNode[T]) bool {
+	if node == nil || node.list != l {
+		return false
+	}
+
+	l.unlink(node)
+	return true
+}
+
+func (l *DoublyLinkedList[T]) MoveToFront(node *DoublyLinkedListNode[T]) {
+	if node == nil || node.list != l {
+		return
+	}
+	l.move(node, &l.sentinel)
+}
+
+func (l *DoublyLinkedList[T]) MoveToBack(node *DoublyLinkedListNode[T]) {
+	if node == nil || node.list != l {
+		return
+	}
+	l.move(node, l.sentinel.prev)
+}
+
+func (l *DoublyLinkedList[T]) Clear() {
+	// 남아있는 노드가 이 리스트를 가리키지 않도록 끊어준다
+	for node := l.sentinel.next; node != nil && node != &l.sentinel; {
+		next := node.next
+		node.next = nil
+		node.prev = nil
+		node.list = nil
+		node = next
+	}
+
+	l.sentinel.next = &l.sentinel
+	l.sentinel.prev = &l.sentinel
+	l.length = 0
+}
+
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.Front(); node != nil; node = node.Next() {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.Back(); node != nil; node = node.Prev() {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package linked_list
+
+import (
+	"slices"
+	"testing"
+)
+
+func collectForward[T any](l *DoublyLinkedList[T]) []T {
+	values := []T{}
+	for node := l.Front(); node != nil; node = node.Next() {
+		values = append(values, node.Value)
+	}
+	return values
+}
+
+func collectBackward[T any](l *DoublyLinkedList[T]) []T {
+	values := []T{}
+	for node := l.Back(); node != nil; node = node.Prev() {
+		values = append(values, node.Value)
+	}
+	return values
+}
+
+func TestDoublyLinkedListZeroValue(t *testing.T) {
+	l := &DoublyLinkedList[int]{}
+
+	if l.Front() != nil || l.Back() != nil || l.Len() != 0 {
+		t.Error("zero value 리스트가 비어있지 않습니다")
+	}
+	if _, ok := l.PopFront(); ok {
+		t.Error("빈 리스트에서 PopFront 가 성공했습니다")
+	}
+	if _, ok := l.PopBack(); ok {
+		t.Error("빈 리스트에서 PopBack 이 성공했습니다")
+	}
+
+	l.PushBack(1)
+	if l.Front() != l.Back() || l.Front().Value != 1 {
+		t.Error("zero value 리스트에 PushBack 이 올바르게 동작하지 않았습니다")
+	}
+}
+
+func TestDoublyLinkedListPushAndPop(t *testing.T) {
+	l := NewDoublyLinkedList(2, 3)
+	l.PushFront(1)
+	l.PushBack(4)
+
+	if got := collectForward(l); !equalInts(got, []int{1, 2, 3, 4}) {
+		t.Errorf("정방향 순회 결과가 올바르지 않습니다: %v", got)
+	}
+	if got := collectBackward(l); !equalInts(got, []int{4, 3, 2, 1}) {
+		t.Errorf("역방향 순회 결과가 올바르지 않습니다: %v", got)
+	}
+
+	if v, _ := l.PopFront(); v != 1 {
+		t.Errorf("PopFront 기대값: 1, 실제값: %d", v)
+	}
+	if v, _ := l.PopBack(); v != 4 {
+		t.Errorf("PopBack 기대값: 4, 실제값: %d", v)
+	}
+	if l.Len() != 2 {
+		t.Errorf("길이가 올바르지 않습니다. 기대값: 2, 실제값: %d", l.Len())
+	}
+}
+
+func TestDoublyLinkedListInsertBeforeAndAfter(t *testing.T) {
+	l := NewDoublyLinkedList(2, 4)
+
+	l.InsertBefore(l.Front(), 1)
+	l.InsertAfter(l.Front().Next(), 3)
+	l.InsertAfter(l.Back(), 5)
+
+	if got := collectForward(l); !equalInts(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Insert 결과가 올바르지 않습니다: %v", got)
+	}
+
+	other := NewDoublyLinkedList(0)
+	if l.InsertAfter(other.Front(), 9) != nil || l.InsertBefore(nil, 9) != nil {
+		t.Error("다른 리스트의 노드 기준으로 삽입이 성공했습니다")
+	}
+	if l.Len() != 5 || other.Len() != 1 {
+		t.Error("잘못된 삽입으로 길이가 변경되었습니다")
+	}
+}
+
+func TestDoublyLinkedListRemove(t *testing.T) {
+	l := &DoublyLinkedList[int]{}
+	nodes := []*DoublyLinkedListNode[int]{}
+	for i := 1; i <= 5; i++ {
+		nodes = append(nodes, l.PushBack(i))
+	}
+
+	// 임의의 노드를 O(1) 로 제거
+	if !l.Remove(nodes[2]) || !l.Remove(nodes[0]) || !l.Remove(nodes[4]) {
+		t.Error("Remove 가 실패했습니다")
+	}
+
+	if got := collectForward(l); !equalInts(got, []int{2, 4}) {
+		t.Errorf("Remove 결과가 올바르지 않습니다: %v", got)
+	}
+	if got := collectBackward(l); !equalInts(got, []int{4, 2}) {
+		t.Errorf("Remove 후 역방향 순회 결과가 올바르지 않습니다: %v", got)
+	}
+
+	// 이미 제거된 노드는 다시 제거할 수 없음
+	if l.Remove(nodes[2]) {
+		t.Error("이미 제거된 노드 Remove 가 성공했습니다")
+	}
+	if nodes[2].Next() != nil || nodes[2].Prev() != nil {
+		t.Error("제거된 노드가 여전히 리스트를 가리킵니다")
+	}
+	if l.Len() != 2 {
+		t.Errorf("길이가 올바르지 않습니다. 기대값: 2, 실제값: %d", l.Len())
+	}
+}
+
+func TestDoublyLinkedListMove(t *testing.T) {
+	l := &DoublyLinkedList[string]{}
+	a := l.PushBack("a")
+	b := l.PushBack("b")
+	c := l.PushBack("c")
+
+	l.MoveToFront(c)
+	if got := collectForward(l); got[0] != "c" || got[1] != "a" || got[2] != "b" {
+		t.Errorf("MoveToFront 결과가 올바르지 않습니다: %v", got)
+	}
+
+	l.MoveToBack(c)
+	l.MoveToBack(a)
+	if got := collectForward(l); got[0] != "b" || got[1] != "c" || got[2] != "a" {
+		t.Errorf("MoveToBack 결과가 올바르지 않습니다: %v", got)
+	}
+
+	// 이미 맨 앞/뒤인 노드를 옮겨도 변하지 않아야 함
+	l.MoveToFront(b)
+	l.MoveToBack(a)
+	if got := collectBackward(l); got[0] != "a" || got[1] != "c" || got[2] != "b" {
+		t.Errorf("제자리 Move 후 결과가 올바르지 않습니다: %v", got)
+	}
+	if l.Len() != 3 {
+		t.Errorf("Move 후 길이가 변경되었습니다. 실제값: %d", l.Len())
+	}
+}
+
+func TestDoublyLinkedListClear(t *testing.T) {
+	l := NewDoublyLinkedList(1, 2, 3)
+	front := l.Front()
+	l.Clear()
+
+	if l.Len() != 0 || l.Front() != nil || l.Back() != nil {
+		t.Error("Clear 후 리스트가 비어있지 않습니다")
+	}
+	if l.Remove(front) {
+		t.Error("Clear 이전 노드 Remove 가 성공했습니다")
+	}
+
+	l.PushBack(4)
+	if got := collectForward(l); !equalInts(got, []int{4}) {
+		t.Errorf("Clear 후 재사용 결과가 올바르지 않습니다: %v", got)
+	}
+}
+
+func TestDoublyLinkedListIterators(t *testing.T) {
+	l := NewDoublyLinkedList(1, 2, 3)
+
+	if got := slices.Collect(l.All()); !equalInts(got, []int{1, 2, 3}) {
+		t.Errorf("All 순회 결과가 올바르지 않습니다: %v", got)
+	}
+	if got := slices.Collect(l.Backward()); !equalInts(got, []int{3, 2, 1}) {
+		t.Errorf("Backward 순회 결과가 올바르지 않습니다: %v", got)
+	}
+	if got := slices.Collect((&DoublyLinkedList[int]{}).All()); len(got) != 0 {
+		t.Errorf("빈 리스트 순회 결과가 비어있지 않습니다: %v", got)
+	}
+}
@@ -1,24 +1,230 @@
 package linked_list
 
-type LinkedListNode struct {
-	Value int
-	Next  *LinkedListNode
+import "iter"
+
+// LinkedListNode 는 리스트 객체 없이 노드끼리 직접 이어 쓰는 단방향 노드이다.
+// head/tail/length 를 함께 관리하려면 LinkedList 를 사용한다.
+type LinkedListNode[T any] struct {
+	Value T
+	Next  *LinkedListNode[T]
 }
 
-func NewLinkedList(value int) *LinkedListNode {
-	return &LinkedListNode{
+func NewLinkedList[T any](value T) *LinkedListNode[T] {
+	return &LinkedListNode[T]{
 		Value: value,
 		Next:  nil,
 	}
 }
 
-func (n *LinkedListNode) Append(value int) *LinkedListNode {
+func (n *LinkedListNode[T]) Append(value T) *LinkedListNode[T] {
 	curr := n
 	for curr.Next != nil {
 		curr = curr.Next
 	}
 
-	newNode := &LinkedListNode{Value: value, Next: nil}
+	newNode := &LinkedListNode[T]{Value: value, Next: nil}
 	curr.Next = newNode
 	return n
 }
+
+// n 부터 마지막 노드까지의 값을 순서대로 순회한다.
+func (n *LinkedListNode[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for curr := n; curr != nil; curr = curr.Next {
+			if !yield(curr.Value) {
+				return
+			}
+		}
+	}
+}
+
+// ListNode 는 LinkedList 가 관리하는 노드이다.
+// next 를 리스트 밖에서 바꾸면 head/tail/length 가 어긋나므로 노드 사이의 연결은 LinkedList 의 메서드로만 바꾼다.
+type ListNode[T any] struct {
+	Value T
+	next  *ListNode[T]
+	list  *LinkedList[T]
+}
+
+// 리스트의 마지막 노드이거나 리스트에서 제거된 노드이면 nil 을 반환한다.
+func (n *ListNode[T]) Next() *ListNode[T] {
+	if n.list == nil {
+		return nil
+	}
+	return n.next
+}
+
+// LinkedList 는 head/tail 포인터와 길이를 함께 관리하는 단방향 연결 리스트이다.
+// zero value 로 바로 사용할 수 있다.
+type LinkedList[T any] struct {
+	head   *ListNode[T]
+	tail   *ListNode[T]
+	length int
+}
+
+func NewList[T any](values ...T) *LinkedList[T] {
+	l := &LinkedList[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+func (l *LinkedList[T]) Len() int {
+	return l.length
+}
+
+func (l *LinkedList[T]) Front() *ListNode[T] {
+	return l.head
+}
+
+func (l *LinkedList[T]) Back() *ListNode[T] {
+	return l.tail
+}
+
+func (l *LinkedList[T]) PushFront(value T) *ListNode[T] {
+	node := &ListNode[T]{Value: value, list: l}
+	node.next = l.head
+	l.head = node
+
+	// 빈 리스트였다면 tail 도 새 노드
+	if l.tail == nil {
+		l.tail = node
+	}
+	l.length++
+
+	return node
+}
+
+func (l *LinkedList[T]) PushBack(value T) *ListNode[T] {
+	node := &ListNode[T]{Value: value, list: l}
+
+	if l.tail == nil {
+		l.head = node
+		l.tail = node
+	} else {
+		l.tail.next = node
+		l.tail = node
+	}
+	l.length++
+
+	return node
+}
+
+// 빈 리스트이면 zero value 와 false 를 반환한다.
+func (l *LinkedList[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+
+	node := l.head
+	l.head = node.next
+	if l.head == nil {
+		l.tail = nil
+	}
+	node.next = nil
+	node.list = nil
+	l.length--
+
+	return node.Value, true
+}
+
+// node 가 이 리스트에 속한 노드가 아니면 (이미 Remove 된 노드 포함) 아무것도 하지 않고 nil 을 반환한다.
+func (l *LinkedList[T]) InsertAfter(node *ListNode[T], value T) *ListNode[T] {
+	if node == nil || node.list != l {
+		return nil
+	}
+
+	new_node := &ListNode[T]{Value: value, list: l}
+	new_node.next = node.next
+	node.next = new_node
+
+	if l.tail == node {
+		l.tail = new_node
+	}
+	l.length++
+
+	return new_node
+}
+
+// 단방향 리스트라 이전 노드를 찾아야 하므로 O(n) 이다.
+// node 가 리스트에 없으면 false 를 반환한다.
+func (l *LinkedList[T]) Remove(node *ListNode[T]) bool {
+	if node == nil || node.list != l {
+		return false
+	}
+
+	if l.head == node {
+		l.PopFront()
+		return true
+	}
+
+	prev := l.head
+	for prev.next != nil && prev.next != node {
+		prev = prev.next
+	}
+
+	if prev.next == nil {
+		return false
+	}
+
+	prev.next = node.next
+	if l.tail == node {
+		l.tail = prev
+	}
+	node.next = nil
+	node.list = nil
+	l.length--
+
+	return true
+}
+
+// match 를 만족하는 첫 번째 노드를 반환하고, 없으면 nil 을 반환한다.
+func (l *LinkedList[T]) Find(match func(T) bool) *ListNode[T] {
+	for curr := l.head; curr != nil; curr = curr.next {
+		if match(curr.Value) {
+			return curr
+		}
+	}
+	return nil
+}
+
+func (l *LinkedList[T]) Reverse() {
+	var prev *ListNode[T]
+	curr := l.head
+	l.tail = l.head
+
+	for curr != nil {
+		next := curr.next
+		curr.next = prev
+		prev = curr
+		curr = next
+	}
+
+	l.head = prev
+}
+
+func (l *LinkedList[T]) Clear() {
+	// 남아있는 노드가 이 리스트를 가리키지 않도록 끊어준다
+	for node := l.head; node != nil; {
+		next := node.next
+		node.next = nil
+		node.list = nil
+		node = next
+	}
+
+	l.head = nil
+	l.tail = nil
+	l.length = 0
+}
+
+func (l *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for curr := l.head; curr != nil; curr = curr.next {
+			if !yield(curr.Value) {
+				return
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package linked_list
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -25,3 +26,249 @@ func TestAppend(t *testing.T) {
 		t.Error("제대로 append 되지 않았습니다")
 	}
 }
+
+func TestGenericLinkedList(t *testing.T) {
+	type point struct {
+		x, y int
+	}
+
+	head := NewLinkedList(point{1, 2})
+	head.Append(point{3, 4}).Append(point{5, 6})
+
+	if head.Next.Next.Value != (point{5, 6}) {
+		t.Errorf("구조체 값이 제대로 append 되지 않았습니다: %v", head.Next.Next.Value)
+	}
+
+	words := NewLinkedList("hello")
+	words.Append("world")
+
+	if words.Next.Value != "world" {
+		t.Errorf("문자열 값이 제대로 append 되지 않았습니다: %s", words.Next.Value)
+	}
+}
+
+func collect[T any](l *LinkedList[T]) []T {
+	values := []T{}
+	for curr := l.head; curr != nil; curr = curr.next {
+		values = append(values, curr.Value)
+	}
+	return values
+}
+
+func equalInts(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestListPushFrontAndBack(t *testing.T) {
+	l := &LinkedList[int]{}
+	l.PushBack(2)
+	l.PushFront(1)
+	l.PushBack(3)
+
+	if got := collect(l); !equalInts(got, []int{1, 2, 3}) {
+		t.Errorf("Push 순서가 올바르지 않습니다: %v", got)
+	}
+	if l.Len() != 3 {
+		t.Errorf("길이가 올바르지 않습니다. 기대값: 3, 실제값: %d", l.Len())
+	}
+	if l.Front().Value != 1 || l.Back().Value != 3 {
+		t.Error("head/tail 이 올바르지 않습니다")
+	}
+}
+
+func TestListPopFront(t *testing.T) {
+	l := NewList(1, 2)
+
+	if v, ok := l.PopFront(); !ok || v != 1 {
+		t.Errorf("PopFront 기대값: 1, 실제값: %d", v)
+	}
+	if v, ok := l.PopFront(); !ok || v != 2 {
+		t.Errorf("PopFront 기대값: 2, 실제값: %d", v)
+	}
+	if _, ok := l.PopFront(); ok {
+		t.Error("빈 리스트에서 PopFront 가 성공했습니다")
+	}
+	if l.Front() != nil || l.Back() != nil || l.Len() != 0 {
+		t.Error("모두 꺼낸 후 리스트가 비어있지 않습니다")
+	}
+
+	// 비운 후 재사용
+	l.PushBack(3)
+	if l.Front() != l.Back() || l.Front().Value != 3 {
+		t.Error("비운 리스트를 재사용할 수 없습니다")
+	}
+}
+
+func TestListInsertAfter(t *testing.T) {
+	l := NewList(1, 3)
+
+	l.InsertAfter(l.Front(), 2)
+	tail := l.InsertAfter(l.Back(), 4)
+
+	if got := collect(l); !equalInts(got, []int{1, 2, 3, 4}) {
+		t.Errorf("InsertAfter 결과가 올바르지 않습니다: %v", got)
+	}
+	if l.Back() != tail {
+		t.Error("마지막 노드 뒤에 삽입한 후 tail 이 갱신되지 않았습니다")
+	}
+	if l.Len() != 4 {
+		t.Errorf("길이가 올바르지 않습니다. 기대값: 4, 실제값: %d", l.Len())
+	}
+}
+
+func TestListNodeNext(t *testing.T) {
+	l := NewList(1, 2, 3)
+
+	got := []int{}
+	for node := l.Front(); node != nil; node = node.Next() {
+		got = append(got, node.Value)
+	}
+	if !equalInts(got, []int{1, 2, 3}) {
+		t.Errorf("Next 로 순회한 결과가 올바르지 않습니다: %v", got)
+	}
+
+	// 리스트에서 빠진 노드는 리스트 안으로 이어지지 않아야 함
+	middle := l.Front().Next()
+	l.Remove(middle)
+	if middle.Next() != nil {
+		t.Error("Remove 된 노드의 Next 는 nil 이어야 합니다")
+	}
+
+	// 노드를 통해서는 리스트를 바꿀 수 없으므로 PushBack 후에도 길이와 순서가 맞아야 함
+	l.PushBack(4)
+	if got := collect(l); !equalInts(got, []int{1, 3, 4}) || l.Len() != 3 || l.Back().Value != 4 {
+		t.Errorf("리스트 상태가 올바르지 않습니다: %v, 길이: %d", got, l.Len())
+	}
+}
+
+func TestListInsertAfterForeignNode(t *testing.T) {
+	l := NewList(1, 2, 3)
+	other := NewList(9)
+
+	removed := l.Find(func(v int) bool { return v == 2 })
+	l.Remove(removed)
+
+	if l.InsertAfter(removed, 5) != nil {
+		t.Error("Remove 된 노드 뒤에는 삽입할 수 없어야 합니다")
+	}
+	if l.InsertAfter(other.Front(), 5) != nil {
+		t.Error("다른 리스트의 노드 뒤에는 삽입할 수 없어야 합니다")
+	}
+	if l.InsertAfter(&ListNode[int]{Value: 7}, 5) != nil {
+		t.Error("리스트 밖에서 만든 노드 뒤에는 삽입할 수 없어야 합니다")
+	}
+
+	front := l.Front()
+	l.Clear()
+	if l.InsertAfter(front, 5) != nil {
+		t.Error("Clear 된 노드 뒤에는 삽입할 수 없어야 합니다")
+	}
+
+	if l.Len() != 0 || other.Len() != 1 {
+		t.Errorf("길이가 올바르지 않습니다. 기대값: 0/1, 실제값: %d/%d", l.Len(), other.Len())
+	}
+}
+
+func TestListRemove(t *testing.T) {
+	l := NewList(1, 2, 3, 4)
+
+	if !l.Remove(l.Find(func(v int) bool { return v == 3 })) {
+		t.Error("중간 노드 Remove 가 실패했습니다")
+	}
+	if !l.Remove(l.Front()) {
+		t.Error("head Remove 가 실패했습니다")
+	}
+	if !l.Remove(l.Back()) {
+		t.Error("tail Remove 가 실패했습니다")
+	}
+
+	if got := collect(l); !equalInts(got, []int{2}) {
+		t.Errorf("Remove 결과가 올바르지 않습니다: %v", got)
+	}
+	if l.Front() != l.Back() || l.Len() != 1 {
+		t.Error("Remove 후 head/tail/길이가 올바르지 않습니다")
+	}
+
+	if l.Remove(&ListNode[int]{Value: 2}) {
+		t.Error("리스트에 없는 노드 Remove 가 성공했습니다")
+	}
+	if l.Remove(nil) {
+		t.Error("nil 노드 Remove 가 성공했습니다")
+	}
+}
+
+func TestListFind(t *testing.T) {
+	l := NewList("apple", "banana", "cherry")
+
+	node := l.Find(func(v string) bool { return v == "banana" })
+	if node == nil || node.Value != "banana" {
+		t.Error("Find 가 값을 찾지 못했습니다")
+	}
+
+	if l.Find(func(v string) bool { return v == "durian" }) != nil {
+		t.Error("없는 값을 Find 가 찾았습니다")
+	}
+}
+
+func TestListReverse(t *testing.T) {
+	l := NewList(1, 2, 3, 4)
+	l.Reverse()
+
+	if got := collect(l); !equalInts(got, []int{4, 3, 2, 1}) {
+		t.Errorf("Reverse 결과가 올바르지 않습니다: %v", got)
+	}
+	if l.Front().Value != 4 || l.Back().Value != 1 {
+		t.Error("Reverse 후 head/tail 이 올바르지 않습니다")
+	}
+
+	// Reverse 후에도 PushBack 이 tail 에 붙어야 함
+	l.PushBack(0)
+	if got := collect(l); !equalInts(got, []int{4, 3, 2, 1, 0}) {
+		t.Errorf("Reverse 후 PushBack 결과가 올바르지 않습니다: %v", got)
+	}
+}
+
+func TestListClear(t *testing.T) {
+	l := NewList(1, 2, 3)
+	l.Clear()
+
+	if l.Len() != 0 || l.Front() != nil || l.Back() != nil {
+		t.Error("Clear 후 리스트가 비어있지 않습니다")
+	}
+}
+
+func TestListAll(t *testing.T) {
+	l := NewList(1, 2, 3, 4)
+
+	if got := slices.Collect(l.All()); !equalInts(got, []int{1, 2, 3, 4}) {
+		t.Errorf("All 순회 결과가 올바르지 않습니다: %v", got)
+	}
+
+	// 중간에 멈출 수 있어야 함
+	got := []int{}
+	for v := range l.All() {
+		if v == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !equalInts(got, []int{1, 2}) {
+		t.Errorf("break 후 순회 결과가 올바르지 않습니다: %v", got)
+	}
+
+	if got := slices.Collect(NewLinkedList(2).Append(3).Append(4).All()); !equalInts(got, []int{2, 3, 4}) {
+		t.Errorf("노드부터의 All 순회 결과가 올바르지 않습니다: %v", got)
+	}
+
+	if got := slices.Collect((&LinkedList[int]{}).All()); len(got) != 0 {
+		t.Errorf("빈 리스트 순회 결과가 비어있지 않습니다: %v", got)
+	}
+}
@@ -0,0 +1,150 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/tmdgusya/go-data-structure/internal/notify"
+)
+
+// 닫힌 큐에 값을 넣으려 하거나, 닫힌 뒤 남은 값을 모두 꺼낸 큐에서 값을 꺼내려 할 때 반환된다.
+var ErrClosed = errors.New("queue: closed queue")
+
+// BlockingQueue 는 여러 goroutine 이 함께 쓰는 고정 용량 큐이다.
+// Put 은 가득 차 있으면, Take 는 비어 있으면 ctx 가 끝나거나 상태가 바뀔 때까지 기다린다.
+// Close 후에는 새 값을 받지 않지만 남아있는 값은 Take 로 모두 꺼낼 수 있다.
+// 기다리는 goroutine 이 없으면 Put/Take 는 할당하지 않는다.
+// 반드시 NewBlockingQueue 로 생성해야 한다.
+type BlockingQueue[T any] struct {
+	mu        sync.Mutex
+	items     *RingQueue[T]
+	closed    bool
+	not_empty notify.Signal
+	not_full  notify.Signal
+}
+
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	return &BlockingQueue[T]{
+		items: NewFixedRingQueue[T](capacity, Reject),
+	}
+}
+
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+func (q *BlockingQueue[T]) Cap() int {
+	return q.items.Cap()
+}
+
+// 큐가 가득 차 있으면 자리가 날 때까지 기다린다.
+// 큐가 닫혀 있으면 ErrClosed 를, ctx 가 먼저 끝나면 ctx.Err() 를 반환한다.
+func (q *BlockingQueue[T]) Put(ctx context.Context, value T) error {
+	q.mu.Lock()
+	for {
+		err := q.tryPutLocked(value)
+		if !errors.Is(err, ErrFull) {
+			q.mu.Unlock()
+			return err
+		}
+
+		wait := q.not_full.Wait()
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			q.mu.Lock()
+			q.not_full.Cancel(wait)
+			q.mu.Unlock()
+			return ctx.Err()
+		}
+
+		q.mu.Lock()
+	}
+}
+
+// 기다리지 않는다. 가득 차 있으면 ErrFull, 닫혀 있으면 ErrClosed 를 반환한다.
+func (q *BlockingQueue[T]) TryPut(value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.tryPutLocked(value)
+}
+
+func (q *BlockingQueue[T]) tryPutLocked(value T) error {
+	if q.closed {
+		return ErrClosed
+	}
+	if err := q.items.TryEnqueue(value); err != nil {
+		return err
+	}
+
+	q.not_empty.Broadcast()
+	return nil
+}
+
+// 큐가 비어 있으면 값이 들어올 때까지 기다린다.
+// 닫힌 큐가 비어 있으면 ErrClosed 를, ctx 가 먼저 끝나면 ctx.Err() 를 반환한다.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	for {
+		value, err := q.tryTakeLocked()
+		if !errors.Is(err, ErrEmpty) {
+			q.mu.Unlock()
+			return value, err
+		}
+
+		wait := q.not_empty.Wait()
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			q.mu.Lock()
+			q.not_empty.Cancel(wait)
+			q.mu.Unlock()
+
+			var zero T
+			return zero, ctx.Err()
+		}
+
+		q.mu.Lock()
+	}
+}
+
+// 기다리지 않는다. 비어 있으면 ErrEmpty, 닫힌 뒤 비어 있으면 ErrClosed 를 반환한다.
+func (q *BlockingQueue[T]) TryTake() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.tryTakeLocked()
+}
+
+func (q *BlockingQueue[T]) tryTakeLocked() (T, error) {
+	value, err := q.items.TryDequeue()
+	if err != nil {
+		if q.closed {
+			return value, ErrClosed
+		}
+		return value, err
+	}
+
+	q.not_full.Broadcast()
+	return value, nil
+}
+
+// 더 이상 값을 받지 않도록 닫고 기다리는 goroutine 을 모두 깨운다. 여러 번 호출해도 된다.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	q.not_empty.Broadcast()
+	q.not_full.Broadcast()
+}
@@ -0,0 +1,245 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	queue "github.com/tmdgusya/go-data-structure/queue"
+)
+
+func TestBlockingQueueTryPutAndTryTake(t *testing.T) {
+	q := queue.NewBlockingQueue[int](2)
+
+	if err := q.TryPut(1); err != nil {
+		t.Errorf("TryPut 이 실패했습니다: %v", err)
+	}
+	if err := q.TryPut(2); err != nil {
+		t.Errorf("TryPut 이 실패했습니다: %v", err)
+	}
+	if err := q.TryPut(3); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("가득 찬 큐에서 TryPut 이 ErrFull 을 반환하지 않았습니다: %v", err)
+	}
+
+	if v, err := q.TryTake(); err != nil || v != 1 {
+		t.Errorf("TryTake 기대값: 1, 실제값: %d, 에러: %v", v, err)
+	}
+	if v, err := q.TryTake(); err != nil || v != 2 {
+		t.Errorf("TryTake 기대값: 2, 실제값: %d, 에러: %v", v, err)
+	}
+	if _, err := q.TryTake(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 큐에서 TryTake 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+}
+
+func TestBlockingQueueTakeWaitsForPut(t *testing.T) {
+	q := queue.NewBlockingQueue[string](1)
+
+	done := make(chan string)
+	go func() {
+		v, err := q.Take(context.Background())
+		if err != nil {
+			t.Errorf("Take 가 실패했습니다: %v", err)
+		}
+		done <- v
+	}()
+
+	// Take 가 먼저 기다리도록 잠시 둔다
+	time.Sleep(10 * time.Millisecond)
+	if err := q.Put(context.Background(), "hello"); err != nil {
+		t.Fatalf("Put 이 실패했습니다: %v", err)
+	}
+
+	select {
+	case v := <-done:
+		if v != "hello" {
+			t.Errorf("Take 기대값: hello, 실제값: %s", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Take 가 깨어나지 않았습니다")
+	}
+}
+
+func TestBlockingQueuePutWaitsForTake(t *testing.T) {
+	q := queue.NewBlockingQueue[int](1)
+	q.TryPut(1)
+
+	done := make(chan error)
+	go func() {
+		done <- q.Put(context.Background(), 2)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("가득 찬 큐에서 Put 이 기다리지 않았습니다")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if v, _ := q.TryTake(); v != 1 {
+		t.Errorf("TryTake 기대값: 1, 실제값: %d", v)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Put 이 실패했습니다: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Put 이 깨어나지 않았습니다")
+	}
+
+	if v, _ := q.TryTake(); v != 2 {
+		t.Errorf("TryTake 기대값: 2, 실제값: %d", v)
+	}
+}
+
+func TestBlockingQueueContextCancel(t *testing.T) {
+	q := queue.NewBlockingQueue[int](1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Take 가 ctx 에러를 반환하지 않았습니다: %v", err)
+	}
+
+	q.TryPut(1)
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if err := q.Put(ctx, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("Put 이 ctx 에러를 반환하지 않았습니다: %v", err)
+	}
+	if q.Len() != 1 {
+		t.Errorf("취소된 Put 이 값을 넣었습니다. Len: %d", q.Len())
+	}
+}
+
+func TestBlockingQueueNoWaiterAllocs(t *testing.T) {
+	q := queue.NewBlockingQueue[int](4)
+
+	// 취소된 Take 가 남긴 대기 표시 때문에 이후 TryPut 이 채널을 새로 만들면 안 됨
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	q.Take(ctx)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		q.TryPut(1)
+		q.TryTake()
+	})
+	if allocs != 0 {
+		t.Errorf("기다리는 goroutine 이 없는데 TryPut/TryTake 가 %v 번 할당했습니다", allocs)
+	}
+}
+
+func TestBlockingQueueCloseDrains(t *testing.T) {
+	q := queue.NewBlockingQueue[int](3)
+	q.TryPut(1)
+	q.TryPut(2)
+	q.Close()
+	q.Close()
+
+	if err := q.Put(context.Background(), 3); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("닫힌 큐에서 Put 이 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+	if err := q.TryPut(3); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("닫힌 큐에서 TryPut 이 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+
+	// 남아있는 값은 꺼낼 수 있어야 함
+	for _, exp := range []int{1, 2} {
+		if v, err := q.Take(context.Background()); err != nil || v != exp {
+			t.Errorf("닫힌 큐에서 Take 기대값: %d, 실제값: %d, 에러: %v", exp, v, err)
+		}
+	}
+
+	if _, err := q.Take(context.Background()); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("비워진 닫힌 큐에서 Take 가 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := q.TryTake(); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("비워진 닫힌 큐에서 TryTake 가 ErrClosed 를 반환하지 않았습니다: %v", err)
+	}
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	q := queue.NewBlockingQueue[int](1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := q.Take(context.Background()); !errors.Is(err, queue.ErrClosed) {
+				t.Errorf("Close 후 Take 가 ErrClosed 를 반환하지 않았습니다: %v", err)
+			}
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Close 가 기다리는 goroutine 을 깨우지 않았습니다")
+	}
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	q := queue.NewBlockingQueue[int](8)
+	producers, consumers, per_producer := 4, 4, 1000
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(p int) {
+			defer produced.Done()
+			for i := 0; i < per_producer; i++ {
+				if err := q.Put(context.Background(), p*per_producer+i); err != nil {
+					t.Errorf("Put 이 실패했습니다: %v", err)
+					return
+				}
+			}
+		}(p)
+	}
+
+	results := make(chan int, producers*per_producer)
+	var consumed sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				v, err := q.Take(context.Background())
+				if errors.Is(err, queue.ErrClosed) {
+					return
+				}
+				results <- v
+			}
+		}()
+	}
+
+	produced.Wait()
+	q.Close()
+	consumed.Wait()
+	close(results)
+
+	seen := make([]bool, producers*per_producer)
+	count := 0
+	for v := range results {
+		if seen[v] {
+			t.Fatalf("값 %d 를 두 번 꺼냈습니다", v)
+		}
+		seen[v] = true
+		count++
+	}
+	if count != producers*per_producer {
+		t.Errorf("꺼낸 값의 개수가 올바르지 않습니다. 기대값: %d, 실제값: %d", producers*per_producer, count)
+	}
+}
@@ -0,0 +1,182 @@
+package queue
+
+import "iter"
+
+// Deque 는 원형 배열 기반 양방향 큐이다. 양쪽 끝의 Push/Pop 이 모두 amortized O(1) 이고
+// 원소마다 노드를 할당하지 않는다. zero value 로 바로 사용할 수 있다.
+type Deque[T any] struct {
+	values []T
+	head   int
+	length int
+}
+
+func NewDeque[T any](size int) *Deque[T] {
+	if size < 1 {
+		size = default_ring_size
+	}
+
+	return &Deque[T]{
+		values: make([]T, size),
+	}
+}
+
+func (d *Deque[T]) Len() int {
+	return d.length
+}
+
+func (d *Deque[T]) Cap() int {
+	return len(d.values)
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.length == 0
+}
+
+// 논리적 위치 i 의 실제 배열 인덱스
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.values)
+}
+
+func (d *Deque[T]) grow() {
+	if d.length < len(d.values) {
+		return
+	}
+
+	new_size := len(d.values) * 2
+	if new_size == 0 {
+		new_size = default_ring_size
+	}
+
+	new_values := make([]T, new_size)
+	for i := 0; i < d.length; i++ {
+		new_values[i] = d.values[d.index(i)]
+	}
+
+	d.values = new_values
+	d.head = 0
+}
+
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+
+	// head 를 한 칸 앞으로 (0 이면 배열 끝으로) 옮긴다
+	d.head = (d.head - 1 + len(d.values)) % len(d.values)
+	d.values[d.head] = value
+	d.length++
+}
+
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+
+	d.values[d.index(d.length)] = value
+	d.length++
+}
+
+// 빈 덱이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryPopFront 를 사용한다.
+func (d *Deque[T]) PopFront() T {
+	value, _ := d.TryPopFront()
+	return value
+}
+
+// 빈 덱이면 ErrEmpty 를 반환한다.
+func (d *Deque[T]) TryPopFront() (T, error) {
+	var zero T
+	if d.length == 0 {
+		return zero, ErrEmpty
+	}
+
+	value := d.values[d.head]
+	d.values[d.head] = zero
+	d.head = d.index(1)
+	d.length--
+
+	return value, nil
+}
+
+// 빈 덱이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryPopBack 을 사용한다.
+func (d *Deque[T]) PopBack() T {
+	value, _ := d.TryPopBack()
+	return value
+}
+
+// 빈 덱이면 ErrEmpty 를 반환한다.
+func (d *Deque[T]) TryPopBack() (T, error) {
+	var zero T
+	if d.length == 0 {
+		return zero, ErrEmpty
+	}
+
+	last := d.index(d.length - 1)
+	value := d.values[last]
+	d.values[last] = zero
+	d.length--
+
+	return value, nil
+}
+
+// 빈 덱이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryFront 를 사용한다.
+func (d *Deque[T]) Front() T {
+	value, _ := d.TryFront()
+	return value
+}
+
+// 빈 덱이면 ErrEmpty 를 반환한다.
+func (d *Deque[T]) TryFront() (T, error) {
+	if d.length == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return d.values[d.head], nil
+}
+
+// 빈 덱이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryBack 을 사용한다.
+func (d *Deque[T]) Back() T {
+	value, _ := d.TryBack()
+	return value
+}
+
+// 빈 덱이면 ErrEmpty 를 반환한다.
+func (d *Deque[T]) TryBack() (T, error) {
+	if d.length == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return d.values[d.index(d.length-1)], nil
+}
+
+// front 로부터 i 번째 원소를 반환한다. 슬라이스와 마찬가지로 범위를 벗어나면 panic 한다.
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.length {
+		panic("queue: Deque index out of range")
+	}
+	return d.values[d.index(i)]
+}
+
+// 용량은 그대로 두고 모든 원소를 버린다.
+func (d *Deque[T]) Clear() {
+	clear(d.values)
+	d.head = 0
+	d.length = 0
+}
+
+// front 부터 back 까지 순회한다.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.length; i++ {
+			if !yield(d.values[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// back 부터 front 까지 순회한다.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.length - 1; i >= 0; i-- {
+			if !yield(d.values[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
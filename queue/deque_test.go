@@ -0,0 +1,141 @@
+package queue_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	queue "github.com/tmdgusya/go-data-structure/queue"
+)
+
+func TestDequePushAndPop(t *testing.T) {
+	d := queue.NewDeque[int](4)
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+
+	if got := slices.Collect(d.All()); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Push 결과가 올바르지 않습니다: %v", got)
+	}
+	if d.Front() != 0 || d.Back() != 3 {
+		t.Errorf("Front/Back 이 올바르지 않습니다. Front: %d, Back: %d", d.Front(), d.Back())
+	}
+
+	if d.PopFront() != 0 || d.PopBack() != 3 || d.PopBack() != 2 || d.PopFront() != 1 {
+		t.Error("Pop 순서가 올바르지 않습니다")
+	}
+
+	if _, err := d.TryPopFront(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 덱에서 TryPopFront 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := d.TryPopBack(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 덱에서 TryPopBack 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := d.TryFront(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 덱에서 TryFront 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+	if _, err := d.TryBack(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 덱에서 TryBack 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+
+	// 저장된 zero value 는 빈 덱과 구분되어야 함
+	d.PushBack(0)
+	if v, err := d.TryFront(); err != nil || v != 0 {
+		t.Errorf("TryFront 기대값: 0, 실제값: %d, 에러: %v", v, err)
+	}
+	if v, err := d.TryBack(); err != nil || v != 0 {
+		t.Errorf("TryBack 기대값: 0, 실제값: %d, 에러: %v", v, err)
+	}
+}
+
+func TestDequeGrowth(t *testing.T) {
+	d := &queue.Deque[int]{}
+
+	// 양쪽으로 번갈아 넣어서 wrap around 상태에서 늘어나게 만듦
+	for i := 1; i <= 500; i++ {
+		d.PushFront(-i)
+		d.PushBack(i)
+	}
+
+	if d.Len() != 1000 {
+		t.Fatalf("Len 이 올바르지 않습니다. 기대값: 1000, 실제값: %d", d.Len())
+	}
+
+	for i := 0; i < d.Len(); i++ {
+		expected := i - 500
+		if i >= 500 {
+			expected = i - 499
+		}
+		if d.At(i) != expected {
+			t.Fatalf("At(%d) 기대값: %d, 실제값: %d", i, expected, d.At(i))
+		}
+	}
+}
+
+func TestDequeAt(t *testing.T) {
+	d := queue.NewDeque[string](2)
+	d.PushBack("b")
+	d.PushFront("a")
+	d.PushBack("c")
+
+	for i, exp := range []string{"a", "b", "c"} {
+		if d.At(i) != exp {
+			t.Errorf("At(%d) 기대값: %s, 실제값: %s", i, exp, d.At(i))
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("범위 밖 At 이 panic 하지 않았습니다")
+		}
+	}()
+	d.At(3)
+}
+
+func TestDequeSlidingWindowMax(t *testing.T) {
+	// 슬라이딩 윈도우 최댓값: 인덱스를 감소하는 값 순서로 유지
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	k := 3
+	expected := []int{3, 3, 5, 5, 6, 7}
+
+	window := queue.NewDeque[int](k)
+	result := []int{}
+	for i, n := range nums {
+		if !window.IsEmpty() && window.Front() <= i-k {
+			window.PopFront()
+		}
+		for !window.IsEmpty() && nums[window.Back()] <= n {
+			window.PopBack()
+		}
+		window.PushBack(i)
+
+		if i >= k-1 {
+			result = append(result, nums[window.Front()])
+		}
+	}
+
+	if !slices.Equal(result, expected) {
+		t.Errorf("슬라이딩 윈도우 최댓값이 올바르지 않습니다. 기대값: %v, 실제값: %v", expected, result)
+	}
+}
+
+func TestDequeIteratorsAndClear(t *testing.T) {
+	d := queue.NewDeque[int](3)
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+
+	if got := slices.Collect(d.Backward()); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("Backward 순회 결과가 올바르지 않습니다: %v", got)
+	}
+
+	d.Clear()
+	if d.Len() != 0 || d.Front() != 0 || d.Back() != 0 {
+		t.Error("Clear 후 덱이 비어있지 않습니다")
+	}
+	if d.Cap() != 3 {
+		t.Errorf("Clear 후 용량이 변경되었습니다. 실제값: %d", d.Cap())
+	}
+}
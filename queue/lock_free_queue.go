@@ -0,0 +1,95 @@
+package queue
+
+import "sync/atomic"
+
+type lockFreeNode[T any] struct {
+	value T
+	next  atomic.Pointer[lockFreeNode[T]]
+}
+
+// LockFreeQueue 는 Michael–Scott 알고리즘으로 구현한 lock-free MPMC 큐이다.
+// 연결 리스트 큐와 같은 구조지만 head/tail/next 를 CAS 로만 바꾸므로 생산자와 소비자 모두 잠들지 않는다.
+// head 는 항상 dummy 노드를 가리키고 실제 첫 원소는 head.next 에 있다.
+// 반드시 NewLockFreeQueue 로 생성해야 한다.
+type LockFreeQueue[T any] struct {
+	head   atomic.Pointer[lockFreeNode[T]]
+	tail   atomic.Pointer[lockFreeNode[T]]
+	length atomic.Int64
+}
+
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	dummy := &lockFreeNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// 동시에 Enqueue/Dequeue 가 일어나는 중에는 근사치이다.
+func (q *LockFreeQueue[T]) Len() int {
+	return int(max(q.length.Load(), 0))
+}
+
+func (q *LockFreeQueue[T]) Enqueue(value T) {
+	node := &lockFreeNode[T]{value: value}
+
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+
+		// 읽는 사이에 tail 이 바뀌었으면 다시 시도
+		if tail != q.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			// 다른 생산자가 노드를 붙여놓고 tail 을 아직 못 옮겼으면 대신 옮겨준다
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, node) {
+			// 실패해도 다른 goroutine 이 옮겨주므로 결과는 신경쓰지 않는다
+			q.tail.CompareAndSwap(tail, node)
+			q.length.Add(1)
+			return
+		}
+	}
+}
+
+// 빈 큐이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryDequeue 를 사용한다.
+func (q *LockFreeQueue[T]) Dequeue() T {
+	value, _ := q.TryDequeue()
+	return value
+}
+
+// 기다리지 않는다. 빈 큐이면 ErrEmpty 를 반환한다.
+func (q *LockFreeQueue[T]) TryDequeue() (T, error) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+
+		if head != q.head.Load() {
+			continue
+		}
+
+		if head == tail {
+			if next == nil {
+				var zero T
+				return zero, ErrEmpty
+			}
+			// tail 이 뒤처져 있으면 먼저 옮겨준다
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// CAS 에 성공하면 next 가 새 dummy 가 된다.
+		// 다른 소비자가 동시에 next.value 를 읽고 있을 수 있으므로 꺼낸 뒤에도 값을 비우지 않는다.
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.length.Add(-1)
+			return value, nil
+		}
+	}
+}
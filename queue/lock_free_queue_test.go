@@ -0,0 +1,162 @@
+package queue_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	queue "github.com/tmdgusya/go-data-structure/queue"
+)
+
+func TestLockFreeQueueFIFO(t *testing.T) {
+	q := queue.NewLockFreeQueue[int]()
+
+	if _, err := q.TryDequeue(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 큐에서 TryDequeue 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+
+	for i := 1; i <= 100; i++ {
+		q.Enqueue(i)
+	}
+	if q.Len() != 100 {
+		t.Errorf("Len 이 올바르지 않습니다. 기대값: 100, 실제값: %d", q.Len())
+	}
+
+	for i := 1; i <= 100; i++ {
+		if val := q.Dequeue(); val != i {
+			t.Fatalf("FIFO 순서가 맞지 않습니다. 기대값 %d, 실제값 %d", i, val)
+		}
+	}
+
+	if q.Dequeue() != 0 || q.Len() != 0 {
+		t.Error("모든 값을 꺼낸 후 큐가 비어있지 않습니다")
+	}
+}
+
+func TestLockFreeQueueConcurrent(t *testing.T) {
+	q := queue.NewLockFreeQueue[int]()
+	producers, consumers, per_producer := 8, 8, 5000
+	total := producers * per_producer
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(p int) {
+			defer produced.Done()
+			for i := 0; i < per_producer; i++ {
+				q.Enqueue(p*per_producer + i)
+			}
+		}(p)
+	}
+
+	// 소비자는 생산자별로 받은 값이 증가하는지(= 생산자 하나 안에서 FIFO) 확인
+	results := make([][]int, consumers)
+	var remaining sync.WaitGroup
+	remaining.Add(total)
+
+	var consumed sync.WaitGroup
+	done := make(chan struct{})
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func(c int) {
+			defer consumed.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				v, err := q.TryDequeue()
+				if err != nil {
+					continue
+				}
+				results[c] = append(results[c], v)
+				remaining.Done()
+			}
+		}(c)
+	}
+
+	produced.Wait()
+	remaining.Wait()
+	close(done)
+	consumed.Wait()
+
+	seen := make([]bool, total)
+	for _, values := range results {
+		last := make([]int, producers)
+		for i := range last {
+			last[i] = -1
+		}
+
+		for _, v := range values {
+			if seen[v] {
+				t.Fatalf("값 %d 를 두 번 꺼냈습니다", v)
+			}
+			seen[v] = true
+
+			p := v / per_producer
+			if v <= last[p] {
+				t.Fatalf("같은 생산자의 값이 순서를 벗어났습니다. 이전값: %d, 현재값: %d", last[p], v)
+			}
+			last[p] = v
+		}
+	}
+
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("값 %d 가 사라졌습니다", v)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("모든 값을 꺼낸 후 Len 이 0이 아닙니다. 실제값: %d", q.Len())
+	}
+}
+
+// 비교 대상: mutex 로 감싼 연결 리스트 큐
+type mutexQueue struct {
+	mu sync.Mutex
+	q  queue.Queue[int]
+}
+
+func (m *mutexQueue) Enqueue(value int) {
+	m.mu.Lock()
+	m.q.Enqueue(value)
+	m.mu.Unlock()
+}
+
+func (m *mutexQueue) TryDequeue() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.q.TryDequeue()
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	q := queue.NewLockFreeQueue[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				q.Enqueue(i)
+			} else {
+				q.TryDequeue()
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkMutexQueue(b *testing.B) {
+	q := &mutexQueue{}
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				q.Enqueue(i)
+			} else {
+				q.TryDequeue()
+			}
+			i++
+		}
+	})
+}
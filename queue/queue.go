@@ -1,47 +1,92 @@
 package queue
 
 import (
+	"errors"
+	"iter"
+
 	linked_list "github.com/tmdgusya/go-data-structure/linked_list"
 )
 
-type Queue struct {
-	front *linked_list.LinkedListNode
-	back  *linked_list.LinkedListNode
+// 빈 큐에서 값을 꺼내려고 할 때 반환된다.
+var ErrEmpty = errors.New("queue: empty queue")
+
+// 고정 용량 큐가 가득 차서 값을 넣을 수 없을 때 반환된다.
+var ErrFull = errors.New("queue: full queue")
+
+// Interface 는 연결 리스트 큐(Queue)와 링 버퍼 큐(RingQueue)가 공통으로 제공하는 동작이다.
+// 구현을 바꿔 끼울 수 있도록 호출하는 쪽에서는 이 타입으로 받는다.
+type Interface[T any] interface {
+	TryEnqueue(value T) error
+	Dequeue() T
+	TryDequeue() (T, error)
+	Peek() T
+	TryPeek() (T, error)
+	Len() int
+	All() iter.Seq[T]
 }
 
-func NewQueue(value int) *Queue {
-	node := linked_list.NewLinkedList(value)
-	return &Queue{
-		front: node,
-		back:  node,
-	}
+var (
+	_ Interface[int] = (*Queue[int])(nil)
+	_ Interface[int] = (*RingQueue[int])(nil)
+)
+
+type Queue[T any] struct {
+	list linked_list.LinkedList[T]
 }
 
-func (q *Queue) Enqueue(value int) *Queue {
-	node := linked_list.NewLinkedList(value)
+func NewQueue[T any](value T) *Queue[T] {
+	q := &Queue[T]{}
+	q.list.PushBack(value)
+	return q
+}
 
-	// 빈 큐인 경우
-	if q.back == nil {
-		q.front = node
-		q.back = node
-		return q
-	}
+func (q *Queue[T]) Len() int {
+	return q.list.Len()
+}
 
-	q.back.Next = node
-	q.back = node
+func (q *Queue[T]) Enqueue(value T) *Queue[T] {
+	q.list.PushBack(value)
 	return q
 }
 
-func (q *Queue) Dequeue() int {
-	if q.front == nil {
-		return -1
-	}
+// 연결 리스트 큐는 용량 제한이 없으므로 항상 nil 을 반환한다.
+func (q *Queue[T]) TryEnqueue(value T) error {
+	q.list.PushBack(value)
+	return nil
+}
 
-	value := q.front.Value
-	q.front = q.front.Next
+// 빈 큐이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryDequeue 를 사용한다.
+func (q *Queue[T]) Dequeue() T {
+	value, _ := q.TryDequeue()
+	return value
+}
 
-	if q.front == nil {
-		q.back = nil
+// 빈 큐이면 ErrEmpty 를 반환한다.
+func (q *Queue[T]) TryDequeue() (T, error) {
+	value, ok := q.list.PopFront()
+	if !ok {
+		return value, ErrEmpty
 	}
+	return value, nil
+}
+
+// 빈 큐이면 T 의 zero value 를 반환한다.
+func (q *Queue[T]) Peek() T {
+	value, _ := q.TryPeek()
 	return value
 }
+
+// 빈 큐이면 ErrEmpty 를 반환한다.
+func (q *Queue[T]) TryPeek() (T, error) {
+	front := q.list.Front()
+	if front == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return front.Value, nil
+}
+
+// front 부터 back 까지 Dequeue 순서대로 순회한다.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return q.list.All()
+}
@@ -1,6 +1,8 @@
 package queue_test
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	queue "github.com/tmdgusya/go-data-structure/queue"
@@ -44,9 +46,9 @@ func TestDequeue(t *testing.T) {
 		t.Error("Dequeue가 올바른 순서로 값을 반환하지 않았습니다")
 	}
 
-	// 빈 큐에서 Dequeue하면 -1 반환
-	if q.Dequeue() != -1 {
-		t.Error("빈 큐에서 Dequeue가 -1을 반환하지 않았습니다")
+	// 빈 큐에서 Dequeue하면 zero value 반환
+	if q.Dequeue() != 0 {
+		t.Error("빈 큐에서 Dequeue가 zero value(0)를 반환하지 않았습니다")
 	}
 }
 
@@ -75,12 +77,12 @@ func TestEmptyQueueDequeue(t *testing.T) {
 	q.Dequeue()
 
 	// 빈 큐에서 여러 번 Dequeue
-	if q.Dequeue() != -1 {
-		t.Error("빈 큐에서 Dequeue가 -1을 반환하지 않았습니다")
+	if q.Dequeue() != 0 {
+		t.Error("빈 큐에서 Dequeue가 zero value(0)를 반환하지 않았습니다")
 	}
 
-	if q.Dequeue() != -1 {
-		t.Error("빈 큐에서 연속 Dequeue가 -1을 반환하지 않았습니다")
+	if q.Dequeue() != 0 {
+		t.Error("빈 큐에서 연속 Dequeue가 zero value(0)를 반환하지 않았습니다")
 	}
 }
 
@@ -107,7 +109,7 @@ func TestQueueReuse(t *testing.T) {
 
 func TestQueueEnqueueFromEmpty(t *testing.T) {
 	// 빈 큐 생성 (front와 back이 nil인 상태)
-	q := &queue.Queue{}
+	q := &queue.Queue[int]{}
 
 	// 빈 큐에서 Enqueue
 	q.Enqueue(1)
@@ -127,8 +129,8 @@ func TestQueueEnqueueFromEmpty(t *testing.T) {
 		t.Error("Dequeue가 올바른 순서로 값을 반환하지 않았습니다")
 	}
 
-	if q.Dequeue() != -1 {
-		t.Error("모든 값을 Dequeue한 후 -1을 반환하지 않았습니다")
+	if q.Dequeue() != 0 {
+		t.Error("모든 값을 Dequeue한 후 zero value(0)를 반환하지 않았습니다")
 	}
 }
 
@@ -148,3 +150,84 @@ func TestQueueLargeData(t *testing.T) {
 		}
 	}
 }
+
+func TestQueueWithPointers(t *testing.T) {
+	type job struct {
+		id string
+	}
+
+	a, b := &job{"a"}, &job{"b"}
+	q := queue.NewQueue(a)
+	q.Enqueue(b)
+
+	if q.Dequeue() != a {
+		t.Error("포인터 큐에서 첫 번째 값이 나오지 않았습니다")
+	}
+	if q.Dequeue() != b {
+		t.Error("포인터 큐에서 두 번째 값이 나오지 않았습니다")
+	}
+	if q.Dequeue() != nil {
+		t.Error("빈 포인터 큐에서 Dequeue가 nil을 반환하지 않았습니다")
+	}
+}
+
+func TestQueueLen(t *testing.T) {
+	q := queue.NewQueue(1)
+	q.Enqueue(2).Enqueue(3)
+
+	if q.Len() != 3 {
+		t.Errorf("Len 이 올바르지 않습니다. 기대값: 3, 실제값: %d", q.Len())
+	}
+
+	q.Dequeue()
+	q.Dequeue()
+	q.Dequeue()
+	q.Dequeue()
+
+	if q.Len() != 0 {
+		t.Errorf("빈 큐의 Len 이 0이 아닙니다. 실제값: %d", q.Len())
+	}
+}
+
+func TestQueueAll(t *testing.T) {
+	q := queue.NewQueue(1)
+	q.Enqueue(2).Enqueue(3)
+
+	if got := slices.Collect(q.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("All 순회 결과가 Dequeue 순서와 다릅니다: %v", got)
+	}
+	if q.Len() != 3 {
+		t.Error("순회 후 큐가 변경되었습니다")
+	}
+}
+
+func TestTryDequeue(t *testing.T) {
+	q := queue.NewQueue(-1)
+	q.Enqueue(0)
+
+	if value, err := q.TryDequeue(); err != nil || value != -1 {
+		t.Errorf("TryDequeue 가 저장된 -1 을 반환하지 않았습니다. 값: %d, 에러: %v", value, err)
+	}
+
+	// 저장된 zero value 와 빈 큐를 구분할 수 있어야 함
+	if value, err := q.TryDequeue(); err != nil || value != 0 {
+		t.Errorf("TryDequeue 가 저장된 0 을 반환하지 않았습니다. 값: %d, 에러: %v", value, err)
+	}
+
+	if _, err := q.TryDequeue(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 큐에서 TryDequeue 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+}
+
+func TestQueuePeek(t *testing.T) {
+	q := &queue.Queue[int]{}
+
+	if _, err := q.TryPeek(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 큐에서 TryPeek 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+
+	q.Enqueue(7).Enqueue(8)
+	if q.Peek() != 7 || q.Len() != 2 {
+		t.Error("Peek 이 올바르게 동작하지 않았습니다")
+	}
+}
@@ -0,0 +1,162 @@
+package queue
+
+import "iter"
+
+// 고정 용량 RingQueue 가 가득 찼을 때의 동작
+type FullPolicy int
+
+const (
+	// 새 값을 넣지 않는다. TryEnqueue 는 ErrFull 을 반환한다.
+	Reject FullPolicy = iota
+	// 가장 오래된 값을 버리고 새 값을 넣는다.
+	Overwrite
+)
+
+const default_ring_size = 16
+
+// RingQueue 는 원형 배열 기반 큐이다. Enqueue 마다 노드를 할당하는 Queue 와 달리
+// 배열 하나를 재사용하므로 GC 부담이 적다.
+// 기본적으로 가득 차면 두 배로 늘어나고, NewFixedRingQueue 로 만들면 용량이 고정된다.
+type RingQueue[T any] struct {
+	values []T
+	head   int
+	length int
+	fixed  bool
+	policy FullPolicy
+}
+
+func NewRingQueue[T any](size int) *RingQueue[T] {
+	if size < 1 {
+		size = default_ring_size
+	}
+
+	return &RingQueue[T]{
+		values: make([]T, size),
+	}
+}
+
+func NewFixedRingQueue[T any](capacity int, policy FullPolicy) *RingQueue[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &RingQueue[T]{
+		values: make([]T, capacity),
+		fixed:  true,
+		policy: policy,
+	}
+}
+
+func (q *RingQueue[T]) Len() int {
+	return q.length
+}
+
+func (q *RingQueue[T]) Cap() int {
+	return len(q.values)
+}
+
+func (q *RingQueue[T]) IsFull() bool {
+	return q.length == len(q.values)
+}
+
+// 논리적 위치 i 의 실제 배열 인덱스
+func (q *RingQueue[T]) index(i int) int {
+	return (q.head + i) % len(q.values)
+}
+
+func (q *RingQueue[T]) resize() {
+	new_size := len(q.values) * 2
+	if new_size == 0 {
+		new_size = default_ring_size
+	}
+
+	// head 부터 차례대로 펼쳐서 옮긴다
+	new_values := make([]T, new_size)
+	for i := 0; i < q.length; i++ {
+		new_values[i] = q.values[q.index(i)]
+	}
+
+	q.values = new_values
+	q.head = 0
+}
+
+// 고정 용량 큐가 Reject 정책으로 가득 차 있으면 값은 버려진다.
+// 이를 알아야 하면 TryEnqueue 를 사용한다.
+func (q *RingQueue[T]) Enqueue(value T) *RingQueue[T] {
+	_ = q.TryEnqueue(value)
+	return q
+}
+
+// 고정 용량 큐가 Reject 정책으로 가득 차 있으면 ErrFull 을 반환한다.
+func (q *RingQueue[T]) TryEnqueue(value T) error {
+	if q.IsFull() {
+		if !q.fixed {
+			q.resize()
+		} else if q.policy == Overwrite {
+			// 가장 오래된 값 자리에 덮어쓰고 head 를 한 칸 민다
+			q.values[q.head] = value
+			q.head = q.index(1)
+			return nil
+		} else {
+			return ErrFull
+		}
+	}
+
+	q.values[q.index(q.length)] = value
+	q.length++
+	return nil
+}
+
+// 빈 큐이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryDequeue 를 사용한다.
+func (q *RingQueue[T]) Dequeue() T {
+	value, _ := q.TryDequeue()
+	return value
+}
+
+// 빈 큐이면 ErrEmpty 를 반환한다.
+func (q *RingQueue[T]) TryDequeue() (T, error) {
+	var zero T
+	if q.length == 0 {
+		return zero, ErrEmpty
+	}
+
+	value := q.values[q.head]
+	q.values[q.head] = zero
+	q.head = q.index(1)
+	q.length--
+
+	return value, nil
+}
+
+// 빈 큐이면 T 의 zero value 를 반환한다.
+func (q *RingQueue[T]) Peek() T {
+	value, _ := q.TryPeek()
+	return value
+}
+
+// 빈 큐이면 ErrEmpty 를 반환한다.
+func (q *RingQueue[T]) TryPeek() (T, error) {
+	if q.length == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return q.values[q.head], nil
+}
+
+// 용량은 그대로 두고 모든 원소를 버린다.
+func (q *RingQueue[T]) Clear() {
+	clear(q.values)
+	q.head = 0
+	q.length = 0
+}
+
+// front 부터 back 까지 Dequeue 순서대로 순회한다.
+func (q *RingQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.length; i++ {
+			if !yield(q.values[q.index(i)]) {
+				return
+			}
+		}
+	}
+}
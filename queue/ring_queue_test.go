@@ -0,0 +1,168 @@
+package queue_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	queue "github.com/tmdgusya/go-data-structure/queue"
+)
+
+func TestRingQueueFIFO(t *testing.T) {
+	q := queue.NewRingQueue[int](4)
+
+	for i := 1; i <= 3; i++ {
+		q.Enqueue(i)
+	}
+
+	for i := 1; i <= 3; i++ {
+		if val := q.Dequeue(); val != i {
+			t.Errorf("FIFO 순서가 맞지 않습니다. 기대값 %d, 실제값 %d", i, val)
+		}
+	}
+
+	if _, err := q.TryDequeue(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 큐에서 TryDequeue 가 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+}
+
+func TestRingQueueWrapAround(t *testing.T) {
+	q := queue.NewRingQueue[int](4)
+
+	// head 가 배열 끝을 넘어 돌아가도록 넣고 빼기를 반복
+	next := 0
+	expected := 0
+	for round := 0; round < 10; round++ {
+		q.Enqueue(next).Enqueue(next + 1).Enqueue(next + 2)
+		next += 3
+
+		for i := 0; i < 3; i++ {
+			if val := q.Dequeue(); val != expected {
+				t.Fatalf("wrap around 후 순서가 맞지 않습니다. 기대값 %d, 실제값 %d", expected, val)
+			}
+			expected++
+		}
+	}
+
+	if q.Cap() != 4 {
+		t.Errorf("필요 이상으로 늘어났습니다. 기대값: 4, 실제값: %d", q.Cap())
+	}
+}
+
+func TestRingQueueGrowth(t *testing.T) {
+	q := queue.NewRingQueue[int](2)
+
+	// 중간에 head 를 옮겨두고 늘어나게 만듦
+	q.Enqueue(0)
+	q.Dequeue()
+
+	for i := 1; i <= 1000; i++ {
+		q.Enqueue(i)
+	}
+
+	if q.Len() != 1000 {
+		t.Errorf("Len 이 올바르지 않습니다. 기대값: 1000, 실제값: %d", q.Len())
+	}
+	if q.Cap() != 1024 {
+		t.Errorf("Cap 이 올바르지 않습니다. 기대값: 1024, 실제값: %d", q.Cap())
+	}
+
+	for i := 1; i <= 1000; i++ {
+		if val := q.Dequeue(); val != i {
+			t.Fatalf("늘어난 후 FIFO 순서가 맞지 않습니다. 기대값 %d, 실제값 %d", i, val)
+		}
+	}
+}
+
+func TestFixedRingQueueReject(t *testing.T) {
+	q := queue.NewFixedRingQueue[int](2, queue.Reject)
+
+	if err := q.TryEnqueue(1); err != nil {
+		t.Errorf("TryEnqueue 가 실패했습니다: %v", err)
+	}
+	q.Enqueue(2)
+
+	if err := q.TryEnqueue(3); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("가득 찬 큐에서 TryEnqueue 가 ErrFull 을 반환하지 않았습니다: %v", err)
+	}
+	q.Enqueue(4)
+
+	if got := slices.Collect(q.All()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("가득 찬 큐의 내용이 변경되었습니다: %v", got)
+	}
+	if q.Cap() != 2 {
+		t.Errorf("고정 용량 큐가 늘어났습니다. 실제값: %d", q.Cap())
+	}
+}
+
+func TestFixedRingQueueOverwrite(t *testing.T) {
+	q := queue.NewFixedRingQueue[int](3, queue.Overwrite)
+
+	for i := 1; i <= 5; i++ {
+		if err := q.TryEnqueue(i); err != nil {
+			t.Errorf("Overwrite 정책에서 TryEnqueue 가 실패했습니다: %v", err)
+		}
+	}
+
+	// 가장 오래된 1, 2 가 덮어써짐
+	if got := slices.Collect(q.All()); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("Overwrite 결과가 올바르지 않습니다: %v", got)
+	}
+	if q.Peek() != 3 {
+		t.Errorf("Peek 이 가장 오래된 값을 반환하지 않았습니다. 실제값: %d", q.Peek())
+	}
+	if q.Len() != 3 {
+		t.Errorf("Len 이 올바르지 않습니다. 기대값: 3, 실제값: %d", q.Len())
+	}
+}
+
+func TestRingQueuePeekAndClear(t *testing.T) {
+	q := &queue.RingQueue[string]{}
+
+	if _, err := q.TryPeek(); !errors.Is(err, queue.ErrEmpty) {
+		t.Errorf("빈 큐에서 TryPeek 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+
+	q.Enqueue("a").Enqueue("b")
+	if q.Peek() != "a" || q.Len() != 2 {
+		t.Error("Peek 이 올바르게 동작하지 않았습니다")
+	}
+
+	q.Clear()
+	if q.Len() != 0 || q.Peek() != "" {
+		t.Error("Clear 후 큐가 비어있지 않습니다")
+	}
+}
+
+func TestQueueInterface(t *testing.T) {
+	implementations := map[string]queue.Interface[int]{
+		"linked": &queue.Queue[int]{},
+		"ring":   queue.NewRingQueue[int](2),
+	}
+
+	for name, q := range implementations {
+		t.Run(name, func(t *testing.T) {
+			for i := 1; i <= 5; i++ {
+				if err := q.TryEnqueue(i); err != nil {
+					t.Fatalf("TryEnqueue 가 실패했습니다: %v", err)
+				}
+			}
+
+			if q.Peek() != 1 || q.Len() != 5 {
+				t.Errorf("Peek/Len 이 올바르지 않습니다. Peek: %d, Len: %d", q.Peek(), q.Len())
+			}
+			if got := slices.Collect(q.All()); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+				t.Errorf("All 순회 결과가 올바르지 않습니다: %v", got)
+			}
+
+			for i := 1; i <= 5; i++ {
+				if val, err := q.TryDequeue(); err != nil || val != i {
+					t.Errorf("TryDequeue 기대값 %d, 실제값 %d, 에러: %v", i, val, err)
+				}
+			}
+			if _, err := q.TryPeek(); !errors.Is(err, queue.ErrEmpty) {
+				t.Errorf("빈 큐에서 TryPeek 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+			}
+		})
+	}
+}
@@ -1,36 +1,49 @@
 package stack
 
 import (
+	"iter"
+
 	linked_list "github.com/tmdgusya/go-data-structure/linked_list"
 )
 
-type LinkedListStack struct {
-	head *linked_list.LinkedListNode
-	next *linked_list.LinkedListNode
+type LinkedListStack[T any] struct {
+	list linked_list.LinkedList[T]
 }
 
-func NewLinkedListStack(value int) *LinkedListStack {
-	return &LinkedListStack{
-		head: linked_list.NewLinkedList(value),
-		next: nil,
-	}
+func NewLinkedListStack[T any](value T) *LinkedListStack[T] {
+	s := &LinkedListStack[T]{}
+	s.list.PushFront(value)
+	return s
 }
 
-func (s *LinkedListStack) Push(value int) *LinkedListStack {
-	new_node := linked_list.NewLinkedList(value)
-	new_node.Next = s.head
-	s.head = new_node
+func (s *LinkedListStack[T]) Len() int {
+	return s.list.Len()
+}
+
+func (s *LinkedListStack[T]) Push(value T) *LinkedListStack[T] {
+	s.list.PushFront(value)
 
 	return s
 }
 
-func (s *LinkedListStack) Pop() int {
-	if s.head == nil {
-		return -1
+// 빈 스택이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryPop 을 사용한다.
+func (s *LinkedListStack[T]) Pop() T {
+	value, _ := s.TryPop()
+
+	return value
+}
+
+// 빈 스택이면 ErrEmpty 를 반환한다.
+func (s *LinkedListStack[T]) TryPop() (T, error) {
+	value, ok := s.list.PopFront()
+	if !ok {
+		return value, ErrEmpty
 	}
 
-	value := s.head.Value
-	s.head = s.head.Next
+	return value, nil
+}
 
-	return value
+// top 부터 bottom 까지 Pop 순서대로 순회한다.
+func (s *LinkedListStack[T]) All() iter.Seq[T] {
+	return s.list.All()
 }
@@ -1,6 +1,8 @@
 package stack_test
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	stack "github.com/tmdgusya/go-data-structure/stack"
@@ -41,9 +43,9 @@ func TestLinkedListStackPop(t *testing.T) {
 		t.Error("Pop이 올바른 순서로 값을 반환하지 않았습니다")
 	}
 
-	// 빈 스택에서 Pop하면 -1 반환
-	if s.Pop() != -1 {
-		t.Error("빈 스택에서 Pop이 -1을 반환하지 않았습니다")
+	// 빈 스택에서 Pop하면 zero value 반환
+	if s.Pop() != 0 {
+		t.Error("빈 스택에서 Pop이 zero value(0)를 반환하지 않았습니다")
 	}
 }
 
@@ -73,18 +75,18 @@ func TestLinkedListStackEmptyPop(t *testing.T) {
 	s.Pop()
 
 	// 빈 스택에서 여러 번 Pop
-	if s.Pop() != -1 {
-		t.Error("빈 스택에서 Pop이 -1을 반환하지 않았습니다")
+	if s.Pop() != 0 {
+		t.Error("빈 스택에서 Pop이 zero value(0)를 반환하지 않았습니다")
 	}
 
-	if s.Pop() != -1 {
-		t.Error("빈 스택에서 연속 Pop이 -1을 반환하지 않았습니다")
+	if s.Pop() != 0 {
+		t.Error("빈 스택에서 연속 Pop이 zero value(0)를 반환하지 않았습니다")
 	}
 }
 
 func TestLinkedListStackPushFromEmpty(t *testing.T) {
 	// 빈 스택 생성 (head가 nil인 상태)
-	s := &stack.LinkedListStack{}
+	s := &stack.LinkedListStack[int]{}
 
 	// 빈 스택에서 Push
 	s.Push(1)
@@ -104,7 +106,67 @@ func TestLinkedListStackPushFromEmpty(t *testing.T) {
 		t.Error("Pop이 올바른 순서로 값을 반환하지 않았습니다")
 	}
 
-	if s.Pop() != -1 {
-		t.Error("모든 값을 Pop한 후 -1을 반환하지 않았습니다")
+	if s.Pop() != 0 {
+		t.Error("모든 값을 Pop한 후 zero value(0)를 반환하지 않았습니다")
+	}
+}
+
+func TestLinkedListStackWithStrings(t *testing.T) {
+	s := stack.NewLinkedListStack("a")
+	s.Push("b").Push("c")
+
+	expected := []string{"c", "b", "a", ""}
+	for i, exp := range expected {
+		if val := s.Pop(); val != exp {
+			t.Errorf("문자열 스택의 Pop 순서가 맞지 않습니다. 인덱스 %d: 기대값 %q, 실제값 %q", i, exp, val)
+		}
+	}
+}
+
+func TestLinkedListStackLen(t *testing.T) {
+	s := stack.NewLinkedListStack(1)
+	s.Push(2).Push(3)
+
+	if s.Len() != 3 {
+		t.Errorf("Len 이 올바르지 않습니다. 기대값: 3, 실제값: %d", s.Len())
+	}
+
+	s.Pop()
+	s.Pop()
+	s.Pop()
+	s.Pop()
+
+	if s.Len() != 0 {
+		t.Errorf("빈 스택의 Len 이 0이 아닙니다. 실제값: %d", s.Len())
+	}
+}
+
+func TestLinkedListStackAll(t *testing.T) {
+	s := stack.NewLinkedListStack(1)
+	s.Push(2).Push(3)
+
+	if got := slices.Collect(s.All()); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("All 순회 결과가 Pop 순서와 다릅니다: %v", got)
+	}
+	if s.Len() != 3 {
+		t.Error("순회 후 스택이 변경되었습니다")
+	}
+}
+
+func TestLinkedListStackTryPop(t *testing.T) {
+	s := stack.NewLinkedListStack(0)
+	s.Push(-1)
+
+	if value, err := s.TryPop(); err != nil || value != -1 {
+		t.Errorf("TryPop 이 저장된 -1 을 반환하지 않았습니다. 값: %d, 에러: %v", value, err)
+	}
+
+	// 저장된 zero value 와 빈 스택을 구분할 수 있어야 함
+	if value, err := s.TryPop(); err != nil || value != 0 {
+		t.Errorf("TryPop 이 저장된 0 을 반환하지 않았습니다. 값: %d, 에러: %v", value, err)
+	}
+
+	if _, err := s.TryPop(); !errors.Is(err, stack.ErrEmpty) {
+		t.Errorf("빈 스택에서 TryPop 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
 	}
 }
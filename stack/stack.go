@@ -1,54 +1,151 @@
 package stack
 
-type Stack struct {
-	array_size int8
-	top        int8
-	values     []int8
+import (
+	"errors"
+	"iter"
+)
+
+// 빈 스택에서 값을 꺼내려고 할 때 반환된다.
+var ErrEmpty = errors.New("stack: empty stack")
+
+const default_size = 10
+
+// Stack 은 배열 기반 스택이다. 가득 차면 두 배로 늘어나고,
+// 원소 수가 용량의 1/4 이하로 줄면 생성 시 용량 밑으로는 내려가지 않는 선에서 절반으로 줄어든다.
+type Stack[T any] struct {
+	min_size int
+	length   int
+	values   []T
 }
 
-func NewStack() *Stack {
-	return &Stack{
-		array_size: 10,
-		top:        -1,
-		values:     make([]int8, 10),
-	}
+func NewStack[T any]() *Stack[T] {
+	return NewStackWith[T](default_size)
 }
 
-func NewStackWith(size int8) *Stack {
-	return &Stack{
-		array_size: size,
-		top:        -1,
-		values:     make([]int8, size),
+func NewStackWith[T any](size int) *Stack[T] {
+	if size < 1 {
+		size = 1
 	}
+
+	return &Stack[T]{
+		min_size: size,
+		length:   0,
+		values:   make([]T, size),
+	}
+}
+
+// 스택에 들어있는 원소의 개수
+func (s *Stack[T]) Length() int {
+	return s.length
+}
+
+func (s *Stack[T]) Len() int {
+	return s.length
 }
 
-func (s *Stack) Length() int8 {
-	return s.array_size
+// 내부 배열의 크기
+func (s *Stack[T]) Cap() int {
+	return len(s.values)
 }
 
-func (s *Stack) Resize() {
-	new_array_size := s.array_size * 2
-	new_values := make([]int8, new_array_size)
+func (s *Stack[T]) IsEmpty() bool {
+	return s.length == 0
+}
 
-	copy(new_values, s.values)
+func (s *Stack[T]) Resize() {
+	new_array_size := len(s.values) * 2
+	if new_array_size == 0 {
+		new_array_size = 1
+	}
+
+	s.resizeTo(new_array_size)
+}
 
+func (s *Stack[T]) resizeTo(size int) {
+	new_values := make([]T, size)
+	copy(new_values, s.values[:s.length])
 	s.values = new_values
-	s.array_size = new_array_size
 }
 
-func (s *Stack) Push(value int8) {
-	if s.top+1 >= s.array_size {
+// 원소 수가 용량의 1/4 이하이면 절반으로 줄인다.
+func (s *Stack[T]) shrink() {
+	half := len(s.values) / 2
+	if half < s.min_size || s.length > len(s.values)/4 {
+		return
+	}
+
+	s.resizeTo(half)
+}
+
+func (s *Stack[T]) Push(value T) {
+	if s.length >= len(s.values) {
 		s.Resize()
 	}
-	s.top++
-	s.values[s.top] = value
+	s.values[s.length] = value
+	s.length++
+}
+
+// 빈 스택이면 T 의 zero value 를 반환한다. 저장된 zero value 와 구분해야 하면 TryPop 을 사용한다.
+func (s *Stack[T]) Pop() T {
+	value, _ := s.TryPop()
+	return value
+}
+
+// 빈 스택이면 ErrEmpty 를 반환한다.
+func (s *Stack[T]) TryPop() (T, error) {
+	var zero T
+	if s.length == 0 {
+		return zero, ErrEmpty
+	}
+
+	s.length--
+	value := s.values[s.length]
+	// 꺼낸 자리는 GC 가 회수할 수 있도록 비워둔다
+	s.values[s.length] = zero
+
+	s.shrink()
+	return value, nil
+}
+
+// 빈 스택이면 T 의 zero value 를 반환한다.
+func (s *Stack[T]) Peek() T {
+	value, _ := s.TryPeek()
+	return value
+}
+
+// 빈 스택이면 ErrEmpty 를 반환한다.
+func (s *Stack[T]) TryPeek() (T, error) {
+	if s.length == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return s.values[s.length-1], nil
+}
+
+// 모든 원소를 버리고 생성 시 용량으로 되돌린다.
+func (s *Stack[T]) Clear() {
+	s.values = make([]T, max(s.min_size, 1))
+	s.length = 0
+}
+
+// top 부터 bottom 까지 Pop 순서대로 순회한다.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.length - 1; i >= 0; i-- {
+			if !yield(s.values[i]) {
+				return
+			}
+		}
+	}
 }
 
-func (s *Stack) Pop() int8 {
-	result := -1
-	if s.top > -1 {
-		result = int(s.values[s.top])
-		s.top--
+// bottom 부터 top 까지 Push 순서대로 순회한다.
+func (s *Stack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < s.length; i++ {
+			if !yield(s.values[i]) {
+				return
+			}
+		}
 	}
-	return int8(result)
 }
@@ -1,47 +1,49 @@
 package stack_test
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	stack "github.com/tmdgusya/go-data-structure/stack"
 )
 
 func TestResizing(t *testing.T) {
-	s := stack.NewStack()
+	s := stack.NewStack[int8]()
 	initial_size := 10
 
-	if s.Length() != int8(initial_size) {
+	if s.Cap() != initial_size {
 		t.Error("스택이 초기값보다 더 크게 초기화 되었습니다.")
 	}
 
 	s.Resize()
 
-	if s.Length() != int8(initial_size)*2 {
+	if s.Cap() != initial_size*2 {
 		t.Error(("스택이 초기값에서 두배로 증가하지 않았습니다"))
 	}
 }
 
 func TestPush(t *testing.T) {
 	size := 2
-	s := stack.NewStackWith(int8(size))
+	s := stack.NewStackWith[int8](size)
 
 	for i := 1; i <= size; i++ {
 		s.Push(int8(i))
 	}
 
-	if s.Length() != int8(size) {
+	if s.Cap() != size {
 		t.Error("배열이 리사이즈 되었습니다")
 	}
 
 	s.Push(1)
 
-	if s.Length() != int8(size)*2 {
+	if s.Cap() != size*2 {
 		t.Error("배열이 리사이즈가 되지 않았습니다")
 	}
 }
 
 func TestPop(t *testing.T) {
-	s := stack.NewStack()
+	s := stack.NewStack[int8]()
 
 	// Push 3개의 값
 	s.Push(1)
@@ -61,8 +63,159 @@ func TestPop(t *testing.T) {
 		t.Error("Pop이 올바른 순서로 값을 반환하지 않았습니다")
 	}
 
-	// 빈 스택에서 Pop하면 -1 반환
-	if s.Pop() != -1 {
-		t.Error("빈 스택에서 Pop이 -1을 반환하지 않았습니다")
+	// 빈 스택에서 Pop하면 zero value 반환
+	if s.Pop() != 0 {
+		t.Error("빈 스택에서 Pop이 zero value(0)를 반환하지 않았습니다")
+	}
+}
+
+func TestLength(t *testing.T) {
+	s := stack.NewStack[int]()
+
+	if s.Length() != 0 || s.Len() != 0 || !s.IsEmpty() {
+		t.Error("새 스택의 길이가 0이 아닙니다")
+	}
+
+	s.Push(1)
+	s.Push(2)
+
+	// Length 는 배열 크기가 아니라 원소 개수를 반환해야 함
+	if s.Length() != 2 || s.Len() != 2 || s.IsEmpty() {
+		t.Errorf("Length 가 원소 개수를 반환하지 않았습니다. 기대값: 2, 실제값: %d", s.Length())
+	}
+}
+
+func TestPushBeyondInt8(t *testing.T) {
+	// int8 로 크기를 관리하던 시절에는 127 개를 넘기면 overflow 가 났음
+	s := stack.NewStackWith[int](64)
+
+	for i := 0; i < 1000; i++ {
+		s.Push(i)
+	}
+
+	if s.Len() != 1000 {
+		t.Errorf("Length 가 올바르지 않습니다. 기대값: 1000, 실제값: %d", s.Len())
+	}
+	if s.Cap() != 1024 {
+		t.Errorf("Cap 이 올바르지 않습니다. 기대값: 1024, 실제값: %d", s.Cap())
+	}
+
+	for i := 999; i >= 0; i-- {
+		if v := s.Pop(); v != i {
+			t.Fatalf("Pop 순서가 올바르지 않습니다. 기대값: %d, 실제값: %d", i, v)
+		}
+	}
+}
+
+func TestShrinkOnPop(t *testing.T) {
+	s := stack.NewStackWith[int](4)
+
+	for i := 0; i < 16; i++ {
+		s.Push(i)
+	}
+	if s.Cap() != 16 {
+		t.Fatalf("Cap 이 올바르지 않습니다. 기대값: 16, 실제값: %d", s.Cap())
+	}
+
+	// 원소 수가 용량의 1/4 이 되면 절반으로 줄어듦
+	for s.Len() > 5 {
+		s.Pop()
+	}
+	if s.Cap() != 16 {
+		t.Errorf("너무 일찍 줄어들었습니다. 기대값: 16, 실제값: %d", s.Cap())
+	}
+
+	s.Pop()
+	if s.Cap() != 8 {
+		t.Errorf("용량의 1/4 에서 줄어들지 않았습니다. 기대값: 8, 실제값: %d", s.Cap())
+	}
+
+	// 생성 시 용량 밑으로는 줄어들지 않음
+	for !s.IsEmpty() {
+		s.Pop()
+	}
+	if s.Cap() != 4 {
+		t.Errorf("생성 시 용량 밑으로 줄어들었습니다. 기대값: 4, 실제값: %d", s.Cap())
+	}
+}
+
+func TestPeek(t *testing.T) {
+	s := stack.NewStack[string]()
+
+	if _, err := s.TryPeek(); !errors.Is(err, stack.ErrEmpty) {
+		t.Errorf("빈 스택에서 TryPeek 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
+	}
+
+	s.Push("a")
+	s.Push("b")
+
+	if s.Peek() != "b" {
+		t.Errorf("Peek 이 top 을 반환하지 않았습니다. 실제값: %s", s.Peek())
+	}
+	if s.Len() != 2 {
+		t.Error("Peek 이 스택을 변경했습니다")
+	}
+}
+
+func TestClear(t *testing.T) {
+	s := stack.NewStackWith[int](2)
+	for i := 0; i < 10; i++ {
+		s.Push(i)
+	}
+
+	s.Clear()
+
+	if !s.IsEmpty() || s.Cap() != 2 {
+		t.Errorf("Clear 후 스택이 초기 상태가 아닙니다. 길이: %d, 용량: %d", s.Len(), s.Cap())
+	}
+
+	s.Push(42)
+	if s.Pop() != 42 {
+		t.Error("Clear 후 스택을 재사용할 수 없습니다")
+	}
+}
+
+func TestZeroValueStack(t *testing.T) {
+	s := &stack.Stack[int]{}
+
+	s.Push(1)
+	s.Push(2)
+
+	if s.Pop() != 2 || s.Pop() != 1 {
+		t.Error("zero value 스택이 올바르게 동작하지 않았습니다")
+	}
+}
+
+func TestStackIterators(t *testing.T) {
+	s := stack.NewStack[int8]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	if got := slices.Collect(s.All()); !slices.Equal(got, []int8{3, 2, 1}) {
+		t.Errorf("All 순회 결과가 Pop 순서와 다릅니다: %v", got)
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, []int8{1, 2, 3}) {
+		t.Errorf("Backward 순회 결과가 Push 순서와 다릅니다: %v", got)
+	}
+
+	// 순회는 스택을 비우지 않아야 함
+	if s.Pop() != 3 {
+		t.Error("순회 후 스택이 변경되었습니다")
+	}
+}
+
+func TestTryPop(t *testing.T) {
+	s := stack.NewStack[int8]()
+	s.Push(-1)
+
+	// 저장된 -1 과 빈 스택을 구분할 수 있어야 함
+	value, err := s.TryPop()
+	if err != nil || value != -1 {
+		t.Errorf("TryPop 이 저장된 -1 을 반환하지 않았습니다. 값: %d, 에러: %v", value, err)
+	}
+
+	if _, err := s.TryPop(); !errors.Is(err, stack.ErrEmpty) {
+		t.Errorf("빈 스택에서 TryPop 이 ErrEmpty 를 반환하지 않았습니다: %v", err)
 	}
 }
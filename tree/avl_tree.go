@@ -0,0 +1,291 @@
+package tree
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+type avlNode[K constraints.Ordered, V any] struct {
+	key    K
+	value  V
+	left   *avlNode[K, V]
+	right  *avlNode[K, V]
+	parent *avlNode[K, V]
+	height int // 잎 노드가 1 이다
+	size   int // 이 노드를 루트로 하는 서브트리의 노드 수
+}
+
+// nil 노드의 높이는 0 이다.
+func height[K constraints.Ordered, V any](n *avlNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// nil 노드의 크기는 0 이다.
+func (n *avlNode[K, V]) count() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// 자식들의 값으로 높이와 서브트리 크기를 다시 계산한다.
+func (n *avlNode[K, V]) update() {
+	n.height = max(height(n.left), height(n.right)) + 1
+	n.size = n.left.count() + n.right.count() + 1
+}
+
+// 왼쪽 서브트리 높이 - 오른쪽 서브트리 높이
+func (n *avlNode[K, V]) balance() int {
+	return height(n.left) - height(n.right)
+}
+
+func (n *avlNode[K, V]) getKey() K {
+	return n.key
+}
+
+func (n *avlNode[K, V]) getValue() V {
+	return n.value
+}
+
+func (n *avlNode[K, V]) getLeft() *avlNode[K, V] {
+	return n.left
+}
+
+func (n *avlNode[K, V]) getRight() *avlNode[K, V] {
+	return n.right
+}
+
+func (n *avlNode[K, V]) getParent() *avlNode[K, V] {
+	return n.parent
+}
+
+// AVLTree 는 모든 노드에서 두 서브트리의 높이 차이가 1 이하가 되도록 유지하는 자가 균형 이진 탐색 트리이다.
+// 높이가 약 1.44·log n 이하로 RedBlackTree 보다 낮아서 Find 가 많은 경우에 유리하고,
+// 대신 Insert 와 Delete 때 회전이 더 자주 일어난다.
+// 같은 key 는 하나만 저장한다. zero value 로 바로 사용할 수 있다.
+type AVLTree[K constraints.Ordered, V any] struct {
+	root   *avlNode[K, V]
+	length int
+}
+
+func NewAVLTree[K constraints.Ordered, V any]() *AVLTree[K, V] {
+	return &AVLTree[K, V]{}
+}
+
+func (t *AVLTree[K, V]) Len() int {
+	return t.length
+}
+
+func (t *AVLTree[K, V]) find(key K) *avlNode[K, V] {
+	curr := t.root
+	for curr != nil && curr.key != key {
+		if key < curr.key {
+			curr = curr.left
+		} else {
+			curr = curr.right
+		}
+	}
+	return curr
+}
+
+func (t *AVLTree[K, V]) Find(key K) (V, bool) {
+	node := t.find(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+// node 의 부모가 node 대신 child 를 가리키게 한다.
+func (t *AVLTree[K, V]) replaceChild(node *avlNode[K, V], child *avlNode[K, V]) {
+	if node.parent == nil {
+		t.root = child
+	} else if node.parent.left == node {
+		node.parent.left = child
+	} else {
+		node.parent.right = child
+	}
+	if child != nil {
+		child.parent = node.parent
+	}
+}
+
+// x 의 오른쪽 자식 y 를 x 자리로 올리고 새 서브트리 루트 y 를 반환한다.
+func (t *AVLTree[K, V]) rotateLeft(x *avlNode[K, V]) *avlNode[K, V] {
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+	t.replaceChild(x, y)
+	y.left = x
+	x.parent = y
+
+	x.update()
+	y.update()
+	return y
+}
+
+// rotateLeft 의 좌우 반대
+func (t *AVLTree[K, V]) rotateRight(x *avlNode[K, V]) *avlNode[K, V] {
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+	t.replaceChild(x, y)
+	y.right = x
+	x.parent = y
+
+	x.update()
+	y.update()
+	return y
+}
+
+// node 부터 루트까지 올라가면서 높이와 크기를 다시 계산하고 균형이 깨진 곳을 회전으로 바로잡는다.
+func (t *AVLTree[K, V]) rebalance(node *avlNode[K, V]) {
+	for node != nil {
+		node.update()
+
+		switch balance := node.balance(); {
+		case balance > 1:
+			// 왼쪽 자식이 오른쪽으로 기울어 있으면 (LR) 먼저 펴준다
+			if node.left.balance() < 0 {
+				t.rotateLeft(node.left)
+			}
+			node = t.rotateRight(node)
+		case balance < -1:
+			if node.right.balance() > 0 {
+				t.rotateRight(node.right)
+			}
+			node = t.rotateLeft(node)
+		}
+
+		node = node.parent
+	}
+}
+
+// key 가 없으면 넣고 true 를 반환한다. 이미 있으면 value 만 바꾸고 false 를 반환한다.
+func (t *AVLTree[K, V]) Insert(key K, value V) bool {
+	var parent *avlNode[K, V]
+	curr := t.root
+	for curr != nil {
+		if key == curr.key {
+			curr.value = value
+			return false
+		}
+
+		parent = curr
+		if key < curr.key {
+			curr = curr.left
+		} else {
+			curr = curr.right
+		}
+	}
+
+	node := &avlNode[K, V]{key: key, value: value, parent: parent, height: 1, size: 1}
+	if parent == nil {
+		t.root = node
+	} else if key < parent.key {
+		parent.left = node
+	} else {
+		parent.right = node
+	}
+	t.length++
+
+	t.rebalance(parent)
+	return true
+}
+
+// key 가 있으면 지우고 true 를 반환한다.
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	node := t.find(key)
+	if node == nil {
+		return false
+	}
+
+	// 자식이 둘이면 다음 노드의 key/value 를 옮겨오고 다음 노드를 대신 지운다
+	if node.left != nil && node.right != nil {
+		next := minNode(node.right)
+		node.key, node.value = next.key, next.value
+		node = next
+	}
+
+	child := node.left
+	if child == nil {
+		child = node.right
+	}
+	parent := node.parent
+	t.replaceChild(node, child)
+	t.length--
+
+	t.rebalance(parent)
+	return true
+}
+
+// 가장 작은 key. 빈 트리이면 false 를 반환한다.
+func (t *AVLTree[K, V]) Min() (K, V, bool) {
+	return entryOf(minNode(t.root))
+}
+
+// 가장 큰 key. 빈 트리이면 false 를 반환한다.
+func (t *AVLTree[K, V]) Max() (K, V, bool) {
+	return entryOf(maxNode(t.root))
+}
+
+// key 이하인 가장 큰 key. 없으면 false 를 반환한다.
+func (t *AVLTree[K, V]) Floor(key K) (K, V, bool) {
+	return entryOf(floorNode(t.root, key, true))
+}
+
+// key 이상인 가장 작은 key. 없으면 false 를 반환한다.
+func (t *AVLTree[K, V]) Ceiling(key K) (K, V, bool) {
+	return entryOf(ceilingNode(t.root, key, true))
+}
+
+// key 보다 작은 가장 큰 key. 없으면 false 를 반환한다.
+func (t *AVLTree[K, V]) Lower(key K) (K, V, bool) {
+	return entryOf(floorNode(t.root, key, false))
+}
+
+// key 보다 큰 가장 작은 key. 없으면 false 를 반환한다.
+func (t *AVLTree[K, V]) Higher(key K) (K, V, bool) {
+	return entryOf(ceilingNode(t.root, key, false))
+}
+
+// key 보다 작은 key 의 개수. key 가 트리에 있으면 오름차순으로 0 부터 센 위치와 같다.
+func (t *AVLTree[K, V]) Rank(key K) int {
+	return rankOf(t.root, key)
+}
+
+// 오름차순으로 0 부터 세어 k 번째 key. k 가 범위를 벗어나면 false 를 반환한다.
+func (t *AVLTree[K, V]) Select(k int) (K, V, bool) {
+	return entryOf(selectNode(t.root, k))
+}
+
+// key 오름차순으로 순회한다.
+func (t *AVLTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for curr := minNode(t.root); curr != nil; curr = successorNode(curr) {
+			if !yield(curr.key, curr.value) {
+				return
+			}
+		}
+	}
+}
+
+// key 내림차순으로 순회한다.
+func (t *AVLTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for curr := maxNode(t.root); curr != nil; curr = predecessorNode(curr) {
+			if !yield(curr.key, curr.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package tree
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkAVL verifies the BST order, parent links, stored heights and sizes, and the AVL balance rule, and returns the height.
+func checkAVL[K int | string, V any](t *testing.T, tree *AVLTree[K, V]) int {
+	t.Helper()
+
+	if tree.root != nil && tree.root.parent != nil {
+		t.Fatal("root must not have a parent")
+	}
+
+	count := 0
+	var walk func(n *avlNode[K, V]) int
+	walk = func(n *avlNode[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		count++
+
+		for _, child := range []*avlNode[K, V]{n.left, n.right} {
+			if child != nil && child.parent != n {
+				t.Fatalf("broken parent link under key %v", n.key)
+			}
+		}
+		if n.left != nil && n.left.key >= n.key {
+			t.Fatalf("left child %v is not less than %v", n.left.key, n.key)
+		}
+		if n.right != nil && n.right.key <= n.key {
+			t.Fatalf("right child %v is not greater than %v", n.right.key, n.key)
+		}
+
+		left, right := walk(n.left), walk(n.right)
+		if left-right > 1 || right-left > 1 {
+			t.Fatalf("node %v is unbalanced: left height %d, right height %d", n.key, left, right)
+		}
+		if n.height != max(left, right)+1 {
+			t.Fatalf("node %v stores height %d, want %d", n.key, n.height, max(left, right)+1)
+		}
+		if n.size != n.left.count()+n.right.count()+1 {
+			t.Fatalf("node %v stores size %d, want %d", n.key, n.size, n.left.count()+n.right.count()+1)
+		}
+		return n.height
+	}
+
+	h := walk(tree.root)
+	if count != tree.Len() {
+		t.Fatalf("Len() = %d, but tree has %d nodes", tree.Len(), count)
+	}
+	return h
+}
+
+// sortedOracle is a sorted slice of keys with values alongside, used as the reference implementation.
+type sortedOracle struct {
+	keys   []int
+	values []int
+}
+
+func (o *sortedOracle) insert(key int, value int) bool {
+	i, found := slices.BinarySearch(o.keys, key)
+	if found {
+		o.values[i] = value
+		return false
+	}
+	o.keys = slices.Insert(o.keys, i, key)
+	o.values = slices.Insert(o.values, i, value)
+	return true
+}
+
+func (o *sortedOracle) delete(key int) bool {
+	i, found := slices.BinarySearch(o.keys, key)
+	if !found {
+		return false
+	}
+	o.keys = slices.Delete(o.keys, i, i+1)
+	o.values = slices.Delete(o.values, i, i+1)
+	return true
+}
+
+// checkAgainstOracle runs random operations on m and compares every result and the full contents with a sorted slice.
+func checkAgainstOracle(t *testing.T, m OrderedMap[int, int], seed int64, validate func()) {
+	t.Helper()
+	r := rand.New(rand.NewSource(seed))
+	oracle := &sortedOracle{}
+
+	for step := 0; step < 4000; step++ {
+		key := r.Intn(500)
+
+		switch r.Intn(4) {
+		case 0:
+			if got, want := m.Delete(key), oracle.delete(key); got != want {
+				t.Fatalf("step %d: Delete(%d) = %v, want %v", step, key, got, want)
+			}
+		case 1:
+			got, ok := m.Find(key)
+			i, found := slices.BinarySearch(oracle.keys, key)
+			if ok != found || (found && got != oracle.values[i]) {
+				t.Fatalf("step %d: Find(%d) = %d, %v", step, key, got, ok)
+			}
+		default:
+			if got, want := m.Insert(key, step), oracle.insert(key, step); got != want {
+				t.Fatalf("step %d: Insert(%d) = %v, want %v", step, key, got, want)
+			}
+		}
+
+		if m.Len() != len(oracle.keys) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, m.Len(), len(oracle.keys))
+		}
+		if step%10 == 0 {
+			checkOrderedQueries(t, m, oracle, r.Intn(520)-10)
+		}
+		validate()
+	}
+
+	keys, values := []int{}, []int{}
+	for k, v := range m.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	if !slices.Equal(keys, oracle.keys) || !slices.Equal(values, oracle.values) {
+		t.Fatalf("All() = %v / %v, want %v / %v", keys, values, oracle.keys, oracle.values)
+	}
+
+	backward := []int{}
+	for k := range m.Backward() {
+		backward = append(backward, k)
+	}
+	slices.Reverse(backward)
+	if !slices.Equal(backward, oracle.keys) {
+		t.Fatalf("Backward() = %v, want reverse of %v", backward, oracle.keys)
+	}
+}
+
+// checkOrderedQueries compares the ordered queries for key against binary searches on the oracle.
+func checkOrderedQueries(t *testing.T, m OrderedMap[int, int], oracle *sortedOracle, key int) {
+	t.Helper()
+
+	// at returns the oracle entry at i, or false when i is out of range
+	at := func(i int) (int, int, bool) {
+		if i < 0 || i >= len(oracle.keys) {
+			return 0, 0, false
+		}
+		return oracle.keys[i], oracle.values[i], true
+	}
+	check := func(name string, gotKey int, gotValue int, gotOk bool, i int) {
+		t.Helper()
+		wantKey, wantValue, wantOk := at(i)
+		if gotKey != wantKey || gotValue != wantValue || gotOk != wantOk {
+			t.Fatalf("%s = %d, %d, %v, want %d, %d, %v", name, gotKey, gotValue, gotOk, wantKey, wantValue, wantOk)
+		}
+	}
+
+	// lower is the index of the first key >= key, upper the first key > key
+	lower, found := slices.BinarySearch(oracle.keys, key)
+	upper := lower
+	if found {
+		upper++
+	}
+
+	k, v, ok := m.Min()
+	check("Min()", k, v, ok, 0)
+	k, v, ok = m.Max()
+	check("Max()", k, v, ok, len(oracle.keys)-1)
+	k, v, ok = m.Floor(key)
+	check(fmt.Sprintf("Floor(%d)", key), k, v, ok, upper-1)
+	k, v, ok = m.Ceiling(key)
+	check(fmt.Sprintf("Ceiling(%d)", key), k, v, ok, lower)
+	k, v, ok = m.Lower(key)
+	check(fmt.Sprintf("Lower(%d)", key), k, v, ok, lower-1)
+	k, v, ok = m.Higher(key)
+	check(fmt.Sprintf("Higher(%d)", key), k, v, ok, upper)
+
+	if got := m.Rank(key); got != lower {
+		t.Fatalf("Rank(%d) = %d, want %d", key, got, lower)
+	}
+	for _, i := range []int{-1, 0, lower, len(oracle.keys) - 1, len(oracle.keys)} {
+		k, v, ok = m.Select(i)
+		check(fmt.Sprintf("Select(%d)", i), k, v, ok, i)
+	}
+}
+
+func TestOrderedMap_AgainstSortedSlice(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		avl := NewAVLTree[int, int]()
+		checkAgainstOracle(t, avl, seed, func() { checkAVL(t, avl) })
+
+		rb := NewRedBlackTree[int, int]()
+		checkAgainstOracle(t, rb, seed, func() { checkRedBlack(t, rb) })
+	}
+}
+
+func TestAVLTree_AscendingInsertStaysBalanced(t *testing.T) {
+	tree := &AVLTree[int, string]{}
+	n := 1 << 14
+
+	for i := 0; i < n; i++ {
+		tree.Insert(i, "")
+	}
+
+	// an AVL tree with n nodes is at most about 1.44*log2(n+2) high
+	if h := checkAVL(t, tree); h > 21 {
+		t.Errorf("height %d is too large for %d nodes", h, n)
+	}
+
+	for i := 0; i < n; i += 2 {
+		if !tree.Delete(i) {
+			t.Fatalf("Delete(%d) = false, want true", i)
+		}
+	}
+	checkAVL(t, tree)
+	if tree.Len() != n/2 {
+		t.Errorf("Len() = %d, want %d", tree.Len(), n/2)
+	}
+}
+
+func TestAVLTree_InsertReplacesValue(t *testing.T) {
+	var tree AVLTree[string, int]
+
+	if !tree.Insert("a", 1) {
+		t.Error("Insert of a new key should return true")
+	}
+	if tree.Insert("a", 2) {
+		t.Error("Insert of an existing key should return false")
+	}
+	if got, ok := tree.Find("a"); !ok || got != 2 {
+		t.Errorf("Find(a) = %d, %v, want 2, true", got, ok)
+	}
+	if tree.Delete("b") {
+		t.Error("Delete of a missing key should return false")
+	}
+	if !tree.Delete("a") || tree.Len() != 0 || tree.root != nil {
+		t.Error("tree should be empty after deleting its only key")
+	}
+}
+
+func TestOrderedMap_RankSelectRoundTrip(t *testing.T) {
+	for name, m := range map[string]OrderedMap[int, int]{
+		"AVLTree":      NewAVLTree[int, int](),
+		"RedBlackTree": NewRedBlackTree[int, int](),
+	} {
+		for i := 0; i < 1000; i++ {
+			m.Insert(i*2, i)
+		}
+
+		for i := 0; i < 1000; i++ {
+			key, _, ok := m.Select(i)
+			if !ok || key != i*2 {
+				t.Fatalf("%s: Select(%d) = %d, %v, want %d", name, i, key, ok, i*2)
+			}
+			if rank := m.Rank(key); rank != i {
+				t.Fatalf("%s: Rank(%d) = %d, want %d", name, key, rank, i)
+			}
+			// odd keys are missing, so they rank after the even key below them
+			if rank := m.Rank(key + 1); rank != i+1 {
+				t.Fatalf("%s: Rank(%d) = %d, want %d", name, key+1, rank, i+1)
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package tree
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Map 은 key 순서로 정렬된 key/value 맵이다. RedBlackTree 위에 만들어져서 모든 연산이 O(log n) 이다.
+// zero value 로 바로 사용할 수 있다.
+type Map[K constraints.Ordered, V any] struct {
+	tree RedBlackTree[K, V]
+}
+
+func NewMap[K constraints.Ordered, V any]() *Map[K, V] {
+	return &Map[K, V]{}
+}
+
+func (m *Map[K, V]) Len() int {
+	return m.tree.Len()
+}
+
+// key 에 value 를 저장한다. 이미 있으면 덮어쓴다.
+func (m *Map[K, V]) Put(key K, value V) {
+	m.tree.Insert(key, value)
+}
+
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	return m.tree.Find(key)
+}
+
+func (m *Map[K, V]) Has(key K) bool {
+	return m.tree.find(key) != nil
+}
+
+// key 가 있으면 지우고 true 를 반환한다.
+func (m *Map[K, V]) Delete(key K) bool {
+	return m.tree.Delete(key)
+}
+
+// key 가 있으면 저장된 값과 true 를, 없으면 value 를 저장하고 value 와 false 를 반환한다.
+// 트리를 한 번만 내려간다.
+func (m *Map[K, V]) GetOrInsert(key K, value V) (V, bool) {
+	node, inserted := m.tree.insert(key, value)
+	return node.value, !inserted
+}
+
+func (m *Map[K, V]) Min() (K, V, bool) {
+	return m.tree.Min()
+}
+
+func (m *Map[K, V]) Max() (K, V, bool) {
+	return m.tree.Max()
+}
+
+// key 이하인 가장 큰 key
+func (m *Map[K, V]) Floor(key K) (K, V, bool) {
+	return m.tree.Floor(key)
+}
+
+// key 이상인 가장 작은 key
+func (m *Map[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.tree.Ceiling(key)
+}
+
+// key 보다 작은 가장 큰 key
+func (m *Map[K, V]) Lower(key K) (K, V, bool) {
+	return m.tree.Lower(key)
+}
+
+// key 보다 큰 가장 작은 key
+func (m *Map[K, V]) Higher(key K) (K, V, bool) {
+	return m.tree.Higher(key)
+}
+
+// key 보다 작은 key 의 개수
+func (m *Map[K, V]) Rank(key K) int {
+	return m.tree.Rank(key)
+}
+
+// 오름차순으로 0 부터 세어 k 번째 key
+func (m *Map[K, V]) Select(k int) (K, V, bool) {
+	return m.tree.Select(k)
+}
+
+// key 오름차순으로 순회한다.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return m.tree.All()
+}
+
+// key 내림차순으로 순회한다.
+func (m *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return m.tree.Backward()
+}
@@ -0,0 +1,130 @@
+package tree
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestMap_PutGetHasDelete(t *testing.T) {
+	m := NewMap[string, int]()
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("b", 20)
+
+	if m.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", m.Len())
+	}
+	if got, ok := m.Get("b"); !ok || got != 20 {
+		t.Errorf("Get(b) = %d, %v, want 20, true", got, ok)
+	}
+	if _, ok := m.Get("c"); ok {
+		t.Error("Get(c) should not find anything")
+	}
+	if !m.Has("a") || m.Has("c") {
+		t.Error("Has(a) should be true and Has(c) false")
+	}
+
+	if !m.Delete("a") || m.Delete("a") {
+		t.Error("Delete(a) should succeed once")
+	}
+	if m.Has("a") || m.Len() != 1 {
+		t.Errorf("a should be gone, Len() = %d", m.Len())
+	}
+}
+
+func TestMap_GetOrInsert(t *testing.T) {
+	var m Map[int, string]
+
+	if got, loaded := m.GetOrInsert(1, "one"); loaded || got != "one" {
+		t.Errorf("GetOrInsert(1, one) = %q, %v, want one, false", got, loaded)
+	}
+	if got, loaded := m.GetOrInsert(1, "uno"); !loaded || got != "one" {
+		t.Errorf("GetOrInsert(1, uno) = %q, %v, want one, true", got, loaded)
+	}
+	if got, _ := m.Get(1); got != "one" {
+		t.Errorf("GetOrInsert must not overwrite an existing value, Get(1) = %q", got)
+	}
+}
+
+func TestMap_AgainstBuiltinMap(t *testing.T) {
+	r := rand.New(rand.NewSource(17))
+	m := NewMap[int, int]()
+	oracle := map[int]int{}
+
+	for step := 0; step < 5000; step++ {
+		key := r.Intn(400)
+
+		switch r.Intn(4) {
+		case 0:
+			_, exists := oracle[key]
+			if got := m.Delete(key); got != exists {
+				t.Fatalf("step %d: Delete(%d) = %v, want %v", step, key, got, exists)
+			}
+			delete(oracle, key)
+		case 1:
+			want, exists := oracle[key]
+			if !exists {
+				want = step
+				oracle[key] = step
+			}
+			if got, loaded := m.GetOrInsert(key, step); got != want || loaded != exists {
+				t.Fatalf("step %d: GetOrInsert(%d) = %d, %v, want %d, %v", step, key, got, loaded, want, exists)
+			}
+		default:
+			m.Put(key, step)
+			oracle[key] = step
+		}
+
+		if m.Len() != len(oracle) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, m.Len(), len(oracle))
+		}
+	}
+	checkRedBlack(t, &m.tree)
+
+	keys := []int{}
+	for k, v := range m.All() {
+		if oracle[k] != v {
+			t.Fatalf("value for key %d = %d, want %d", k, v, oracle[k])
+		}
+		keys = append(keys, k)
+	}
+	if want := slices.Sorted(maps.Keys(oracle)); !slices.Equal(keys, want) {
+		t.Errorf("All() keys = %v, want %v", keys, want)
+	}
+}
+
+func TestMap_OrderedQueries(t *testing.T) {
+	m := NewMap[int, string]()
+	for _, k := range []int{10, 20, 30} {
+		m.Put(k, fmt.Sprint(k))
+	}
+
+	if k, v, ok := m.Floor(25); !ok || k != 20 || v != "20" {
+		t.Errorf("Floor(25) = %d, %q, %v, want 20", k, v, ok)
+	}
+	if k, _, ok := m.Ceiling(25); !ok || k != 30 {
+		t.Errorf("Ceiling(25) = %d, %v, want 30", k, ok)
+	}
+	if k, _, ok := m.Lower(10); ok {
+		t.Errorf("Lower(10) = %d, want none", k)
+	}
+	if k, _, ok := m.Higher(30); ok {
+		t.Errorf("Higher(30) = %d, want none", k)
+	}
+	if k, _, ok := m.Min(); !ok || k != 10 {
+		t.Errorf("Min() = %d, %v, want 10", k, ok)
+	}
+	if k, _, ok := m.Max(); !ok || k != 30 {
+		t.Errorf("Max() = %d, %v, want 30", k, ok)
+	}
+	if r := m.Rank(30); r != 2 {
+		t.Errorf("Rank(30) = %d, want 2", r)
+	}
+	if k, _, ok := m.Select(1); !ok || k != 20 {
+		t.Errorf("Select(1) = %d, %v, want 20", k, ok)
+	}
+}
@@ -0,0 +1,32 @@
+package tree
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// OrderedMap 은 key 순서를 유지하는 균형 트리들이 공통으로 제공하는 동작이다.
+// Rank 와 Select 는 서브트리 크기를 노드에 함께 저장해서 O(log n) 이다.
+type OrderedMap[K constraints.Ordered, V any] interface {
+	Insert(key K, value V) bool
+	Delete(key K) bool
+	Find(key K) (V, bool)
+	Len() int
+	All() iter.Seq2[K, V]
+	Backward() iter.Seq2[K, V]
+
+	Min() (K, V, bool)
+	Max() (K, V, bool)
+	Floor(key K) (K, V, bool)
+	Ceiling(key K) (K, V, bool)
+	Lower(key K) (K, V, bool)
+	Higher(key K) (K, V, bool)
+	Rank(key K) int
+	Select(k int) (K, V, bool)
+}
+
+var (
+	_ OrderedMap[int, int] = (*RedBlackTree[int, int])(nil)
+	_ OrderedMap[int, int] = (*AVLTree[int, int])(nil)
+)
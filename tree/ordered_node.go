@@ -0,0 +1,152 @@
+package tree
+
+import "golang.org/x/exp/constraints"
+
+// orderedNode 는 TreeNode, rbNode, avlNode 가 공통으로 제공하는 접근자이다.
+// 노드마다 서브트리 크기를 저장하는 이진 탐색 트리라면 아래 함수들로 순서 질의를 할 수 있다.
+// N 은 노드 포인터 타입이고 N 의 zero value(nil) 가 빈 노드이다.
+type orderedNode[N any, K constraints.Ordered] interface {
+	comparable
+	getKey() K
+	getLeft() N
+	getRight() N
+	getParent() N
+	count() int // nil 노드이면 0
+}
+
+// entryNode 는 key 와 따로 value 를 가지는 노드이다.
+type entryNode[N any, K constraints.Ordered, V any] interface {
+	orderedNode[N, K]
+	getValue() V
+}
+
+// nil 노드이면 false 를 반환한다.
+func entryOf[N entryNode[N, K, V], K constraints.Ordered, V any](n N) (K, V, bool) {
+	var none N
+	if n == none {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.getKey(), n.getValue(), true
+}
+
+// n 을 루트로 하는 서브트리에서 가장 작은 노드. n 이 nil 이면 nil 이다.
+func minNode[N orderedNode[N, K], K constraints.Ordered](n N) N {
+	var none N
+	if n == none {
+		return none
+	}
+	for n.getLeft() != none {
+		n = n.getLeft()
+	}
+	return n
+}
+
+// n 을 루트로 하는 서브트리에서 가장 큰 노드. n 이 nil 이면 nil 이다.
+func maxNode[N orderedNode[N, K], K constraints.Ordered](n N) N {
+	var none N
+	if n == none {
+		return none
+	}
+	for n.getRight() != none {
+		n = n.getRight()
+	}
+	return n
+}
+
+// 중위 순회 기준 다음 노드. 마지막 노드이면 nil 이다.
+func successorNode[N orderedNode[N, K], K constraints.Ordered](n N) N {
+	var none N
+	if n.getRight() != none {
+		return minNode(n.getRight())
+	}
+
+	// 오른쪽 서브트리가 없으면 왼쪽 자식으로 올라오는 첫 조상
+	curr := n
+	for curr.getParent() != none && curr.getParent().getRight() == curr {
+		curr = curr.getParent()
+	}
+	return curr.getParent()
+}
+
+// 중위 순회 기준 이전 노드. 첫 노드이면 nil 이다.
+func predecessorNode[N orderedNode[N, K], K constraints.Ordered](n N) N {
+	var none N
+	if n.getLeft() != none {
+		return maxNode(n.getLeft())
+	}
+
+	curr := n
+	for curr.getParent() != none && curr.getParent().getLeft() == curr {
+		curr = curr.getParent()
+	}
+	return curr.getParent()
+}
+
+// key 보다 작은(inclusive 이면 작거나 같은) key 중 가장 큰 노드
+func floorNode[N orderedNode[N, K], K constraints.Ordered](root N, key K, inclusive bool) N {
+	var none N
+	found := none
+	for curr := root; curr != none; {
+		if curr.getKey() < key || (inclusive && curr.getKey() == key) {
+			found = curr
+			curr = curr.getRight()
+		} else {
+			curr = curr.getLeft()
+		}
+	}
+	return found
+}
+
+// key 보다 큰(inclusive 이면 크거나 같은) key 중 가장 작은 노드
+func ceilingNode[N orderedNode[N, K], K constraints.Ordered](root N, key K, inclusive bool) N {
+	var none N
+	found := none
+	for curr := root; curr != none; {
+		if curr.getKey() > key || (inclusive && curr.getKey() == key) {
+			found = curr
+			curr = curr.getLeft()
+		} else {
+			curr = curr.getRight()
+		}
+	}
+	return found
+}
+
+// key 보다 작은 key 의 개수. 서브트리 크기를 따라 내려가므로 O(h) 이다.
+func rankOf[N orderedNode[N, K], K constraints.Ordered](root N, key K) int {
+	var none N
+	rank := 0
+	for curr := root; curr != none; {
+		if key <= curr.getKey() {
+			curr = curr.getLeft()
+		} else {
+			rank += curr.getLeft().count() + 1
+			curr = curr.getRight()
+		}
+	}
+	return rank
+}
+
+// 오름차순으로 0 부터 세어 k 번째 노드. k 가 범위를 벗어나면 nil 이다. O(h) 이다.
+func selectNode[N orderedNode[N, K], K constraints.Ordered](root N, k int) N {
+	var none N
+	if k < 0 || k >= root.count() {
+		return none
+	}
+
+	curr := root
+	for curr != none {
+		left := curr.getLeft().count()
+		if k < left {
+			curr = curr.getLeft()
+		} else if k == left {
+			break
+		} else {
+			k -= left + 1
+			curr = curr.getRight()
+		}
+	}
+	return curr
+}
@@ -0,0 +1,390 @@
+package tree
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+type rbNode[K constraints.Ordered, V any] struct {
+	key    K
+	value  V
+	left   *rbNode[K, V]
+	right  *rbNode[K, V]
+	parent *rbNode[K, V]
+	red    bool
+	size   int // 이 노드를 루트로 하는 서브트리의 노드 수
+}
+
+// nil 노드는 검은색으로 본다.
+func isRed[K constraints.Ordered, V any](n *rbNode[K, V]) bool {
+	return n != nil && n.red
+}
+
+// nil 노드의 크기는 0 이다.
+func (n *rbNode[K, V]) count() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *rbNode[K, V]) getKey() K {
+	return n.key
+}
+
+func (n *rbNode[K, V]) getValue() V {
+	return n.value
+}
+
+func (n *rbNode[K, V]) getLeft() *rbNode[K, V] {
+	return n.left
+}
+
+func (n *rbNode[K, V]) getRight() *rbNode[K, V] {
+	return n.right
+}
+
+func (n *rbNode[K, V]) getParent() *rbNode[K, V] {
+	return n.parent
+}
+
+// RedBlackTree 는 key 로 정렬된 자가 균형 이진 탐색 트리이다.
+// 루트에서 잎까지의 모든 경로가 같은 수의 검은 노드를 지나고 빨간 노드가 연달아 오지 않으므로
+// 높이가 2·log(n+1) 이하로 유지되어 Insert, Delete, Find 가 O(log n) 이다.
+// 같은 key 는 하나만 저장한다. zero value 로 바로 사용할 수 있다.
+type RedBlackTree[K constraints.Ordered, V any] struct {
+	root   *rbNode[K, V]
+	length int
+}
+
+func NewRedBlackTree[K constraints.Ordered, V any]() *RedBlackTree[K, V] {
+	return &RedBlackTree[K, V]{}
+}
+
+func (t *RedBlackTree[K, V]) Len() int {
+	return t.length
+}
+
+func (t *RedBlackTree[K, V]) find(key K) *rbNode[K, V] {
+	curr := t.root
+	for curr != nil && curr.key != key {
+		if key < curr.key {
+			curr = curr.left
+		} else {
+			curr = curr.right
+		}
+	}
+	return curr
+}
+
+func (t *RedBlackTree[K, V]) Find(key K) (V, bool) {
+	node := t.find(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+// node 의 부모가 node 대신 child 를 가리키게 한다.
+func (t *RedBlackTree[K, V]) replaceChild(node *rbNode[K, V], child *rbNode[K, V]) {
+	if node.parent == nil {
+		t.root = child
+	} else if node.parent.left == node {
+		node.parent.left = child
+	} else {
+		node.parent.right = child
+	}
+	if child != nil {
+		child.parent = node.parent
+	}
+}
+
+// x 의 오른쪽 자식 y 를 x 자리로 올리고 x 를 y 의 왼쪽 자식으로 내린다. y 의 왼쪽 서브트리는 x 의 오른쪽으로 옮긴다.
+func (t *RedBlackTree[K, V]) rotateLeft(x *rbNode[K, V]) {
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+	t.replaceChild(x, y)
+	y.left = x
+	x.parent = y
+
+	y.size = x.size
+	x.size = x.left.count() + x.right.count() + 1
+}
+
+// rotateLeft 의 좌우 반대
+func (t *RedBlackTree[K, V]) rotateRight(x *rbNode[K, V]) {
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+	t.replaceChild(x, y)
+	y.right = x
+	x.parent = y
+
+	y.size = x.size
+	x.size = x.left.count() + x.right.count() + 1
+}
+
+// key 가 없으면 넣고 true 를 반환한다. 이미 있으면 value 만 바꾸고 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Insert(key K, value V) bool {
+	node, inserted := t.insert(key, value)
+	if !inserted {
+		node.value = value
+	}
+	return inserted
+}
+
+// key 가 없으면 value 로 넣는다. 이미 있으면 건드리지 않는다.
+// key 를 가진 노드와 새로 넣었는지를 반환한다. 회전은 노드의 내용을 바꾸지 않으므로 반환한 노드는 계속 key 를 가리킨다.
+func (t *RedBlackTree[K, V]) insert(key K, value V) (*rbNode[K, V], bool) {
+	var parent *rbNode[K, V]
+	curr := t.root
+	for curr != nil {
+		if key == curr.key {
+			return curr, false
+		}
+
+		parent = curr
+		if key < curr.key {
+			curr = curr.left
+		} else {
+			curr = curr.right
+		}
+	}
+
+	node := &rbNode[K, V]{key: key, value: value, parent: parent, red: true, size: 1}
+	if parent == nil {
+		t.root = node
+	} else if key < parent.key {
+		parent.left = node
+	} else {
+		parent.right = node
+	}
+	t.length++
+	for curr := parent; curr != nil; curr = curr.parent {
+		curr.size++
+	}
+
+	t.insertFixup(node)
+	return node, true
+}
+
+// 새로 넣은 빨간 node 와 부모가 둘 다 빨간색이면 색을 바꾸거나 회전해서 규칙을 되살린다.
+func (t *RedBlackTree[K, V]) insertFixup(node *rbNode[K, V]) {
+	for isRed(node.parent) {
+		parent := node.parent
+		grand := parent.parent // 부모가 빨간색이면 루트가 아니므로 조부모가 있다
+
+		if parent == grand.left {
+			uncle := grand.right
+			if isRed(uncle) {
+				// 삼촌도 빨간색이면 색만 바꾸고 조부모에서 다시 확인
+				parent.red = false
+				uncle.red = false
+				grand.red = true
+				node = grand
+				continue
+			}
+
+			if node == parent.right {
+				// 꺾인 모양이면 먼저 펴준다
+				t.rotateLeft(parent)
+				node, parent = parent, node
+			}
+			parent.red = false
+			grand.red = true
+			t.rotateRight(grand)
+		} else {
+			uncle := grand.left
+			if isRed(uncle) {
+				parent.red = false
+				uncle.red = false
+				grand.red = true
+				node = grand
+				continue
+			}
+
+			if node == parent.left {
+				t.rotateRight(parent)
+				node, parent = parent, node
+			}
+			parent.red = false
+			grand.red = true
+			t.rotateLeft(grand)
+		}
+	}
+
+	t.root.red = false
+}
+
+// key 가 있으면 지우고 true 를 반환한다.
+func (t *RedBlackTree[K, V]) Delete(key K) bool {
+	node := t.find(key)
+	if node == nil {
+		return false
+	}
+
+	t.deleteNode(node)
+	t.length--
+	return true
+}
+
+func (t *RedBlackTree[K, V]) deleteNode(node *rbNode[K, V]) {
+	// 자식이 둘이면 다음 노드의 key/value 를 옮겨오고 다음 노드를 대신 지운다
+	if node.left != nil && node.right != nil {
+		next := minNode(node.right)
+		node.key, node.value = next.key, next.value
+		node = next
+	}
+
+	// 이제 node 의 자식은 하나 이하이다
+	child := node.left
+	if child == nil {
+		child = node.right
+	}
+	parent := node.parent
+	t.replaceChild(node, child)
+	for curr := parent; curr != nil; curr = curr.parent {
+		curr.size--
+	}
+
+	if !node.red {
+		// 검은 노드가 빠졌으므로 그 자리를 채운 child 쪽 경로의 검은 노드가 하나 모자라다
+		t.deleteFixup(child, parent)
+	}
+}
+
+// node 쪽 경로에 검은 노드가 하나 모자란 상태를 고친다. node 가 nil 일 수 있으므로 부모를 따로 받는다.
+func (t *RedBlackTree[K, V]) deleteFixup(node *rbNode[K, V], parent *rbNode[K, V]) {
+	for node != t.root && !isRed(node) {
+		if node == parent.left {
+			sibling := parent.right
+			if isRed(sibling) {
+				// 형제가 빨간색이면 회전해서 검은 형제를 만든다
+				sibling.red = false
+				parent.red = true
+				t.rotateLeft(parent)
+				sibling = parent.right
+			}
+
+			if !isRed(sibling.left) && !isRed(sibling.right) {
+				// 형제의 자식이 모두 검은색이면 형제를 빨갛게 하고 모자란 검은 노드를 부모로 올린다
+				sibling.red = true
+				node = parent
+				parent = node.parent
+				continue
+			}
+
+			if !isRed(sibling.right) {
+				sibling.left.red = false
+				sibling.red = true
+				t.rotateRight(sibling)
+				sibling = parent.right
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.right.red = false
+			t.rotateLeft(parent)
+			node = t.root
+		} else {
+			sibling := parent.left
+			if isRed(sibling) {
+				sibling.red = false
+				parent.red = true
+				t.rotateRight(parent)
+				sibling = parent.left
+			}
+
+			if !isRed(sibling.left) && !isRed(sibling.right) {
+				sibling.red = true
+				node = parent
+				parent = node.parent
+				continue
+			}
+
+			if !isRed(sibling.left) {
+				sibling.right.red = false
+				sibling.red = true
+				t.rotateLeft(sibling)
+				sibling = parent.left
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.left.red = false
+			t.rotateRight(parent)
+			node = t.root
+		}
+	}
+
+	if node != nil {
+		node.red = false
+	}
+}
+
+// 가장 작은 key. 빈 트리이면 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Min() (K, V, bool) {
+	return entryOf(minNode(t.root))
+}
+
+// 가장 큰 key. 빈 트리이면 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Max() (K, V, bool) {
+	return entryOf(maxNode(t.root))
+}
+
+// key 이하인 가장 큰 key. 없으면 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Floor(key K) (K, V, bool) {
+	return entryOf(floorNode(t.root, key, true))
+}
+
+// key 이상인 가장 작은 key. 없으면 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Ceiling(key K) (K, V, bool) {
+	return entryOf(ceilingNode(t.root, key, true))
+}
+
+// key 보다 작은 가장 큰 key. 없으면 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Lower(key K) (K, V, bool) {
+	return entryOf(floorNode(t.root, key, false))
+}
+
+// key 보다 큰 가장 작은 key. 없으면 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Higher(key K) (K, V, bool) {
+	return entryOf(ceilingNode(t.root, key, false))
+}
+
+// key 보다 작은 key 의 개수. key 가 트리에 있으면 오름차순으로 0 부터 센 위치와 같다.
+func (t *RedBlackTree[K, V]) Rank(key K) int {
+	return rankOf(t.root, key)
+}
+
+// 오름차순으로 0 부터 세어 k 번째 key. k 가 범위를 벗어나면 false 를 반환한다.
+func (t *RedBlackTree[K, V]) Select(k int) (K, V, bool) {
+	return entryOf(selectNode(t.root, k))
+}
+
+// key 오름차순으로 순회한다.
+func (t *RedBlackTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for curr := minNode(t.root); curr != nil; curr = successorNode(curr) {
+			if !yield(curr.key, curr.value) {
+				return
+			}
+		}
+	}
+}
+
+// key 내림차순으로 순회한다.
+func (t *RedBlackTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for curr := maxNode(t.root); curr != nil; curr = predecessorNode(curr) {
+			if !yield(curr.key, curr.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package tree
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkRedBlack verifies the BST order, parent links, subtree sizes and red-black rules, and returns the black height.
+func checkRedBlack[K int | string, V any](t *testing.T, tree *RedBlackTree[K, V]) int {
+	t.Helper()
+
+	if isRed(tree.root) {
+		t.Fatal("root must be black")
+	}
+	if tree.root != nil && tree.root.parent != nil {
+		t.Fatal("root must not have a parent")
+	}
+
+	count := 0
+	var walk func(n *rbNode[K, V]) int
+	walk = func(n *rbNode[K, V]) int {
+		if n == nil {
+			return 1
+		}
+		count++
+
+		for _, child := range []*rbNode[K, V]{n.left, n.right} {
+			if child == nil {
+				continue
+			}
+			if child.parent != n {
+				t.Fatalf("broken parent link under key %v", n.key)
+			}
+			if n.red && child.red {
+				t.Fatalf("red node %v has red child %v", n.key, child.key)
+			}
+		}
+		if n.left != nil && n.left.key >= n.key {
+			t.Fatalf("left child %v is not less than %v", n.left.key, n.key)
+		}
+		if n.right != nil && n.right.key <= n.key {
+			t.Fatalf("right child %v is not greater than %v", n.right.key, n.key)
+		}
+
+		if n.size != n.left.count()+n.right.count()+1 {
+			t.Fatalf("node %v stores size %d, want %d", n.key, n.size, n.left.count()+n.right.count()+1)
+		}
+
+		left, right := walk(n.left), walk(n.right)
+		if left != right {
+			t.Fatalf("black height mismatch at %v: left %d, right %d", n.key, left, right)
+		}
+		if !n.red {
+			left++
+		}
+		return left
+	}
+
+	height := walk(tree.root)
+	if count != tree.Len() {
+		t.Fatalf("Len() = %d, but tree has %d nodes", tree.Len(), count)
+	}
+	return height
+}
+
+func TestRedBlackTree_InsertFindReplace(t *testing.T) {
+	tree := NewRedBlackTree[string, int]()
+
+	if !tree.Insert("b", 2) || !tree.Insert("a", 1) || !tree.Insert("c", 3) {
+		t.Fatal("Insert of a new key should return true")
+	}
+	if tree.Insert("b", 20) {
+		t.Error("Insert of an existing key should return false")
+	}
+	checkRedBlack(t, tree)
+
+	if got, ok := tree.Find("b"); !ok || got != 20 {
+		t.Errorf("Find(b) = %v, %v, want 20, true", got, ok)
+	}
+	if _, ok := tree.Find("z"); ok {
+		t.Error("Find(z) should not find anything")
+	}
+	if tree.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", tree.Len())
+	}
+}
+
+func TestRedBlackTree_ZeroValue(t *testing.T) {
+	var tree RedBlackTree[int, string]
+
+	if tree.Delete(1) {
+		t.Error("Delete on an empty tree should return false")
+	}
+	tree.Insert(1, "one")
+	if got, ok := tree.Find(1); !ok || got != "one" {
+		t.Errorf("Find(1) = %q, %v, want one, true", got, ok)
+	}
+}
+
+func TestRedBlackTree_AscendingInsertStaysBalanced(t *testing.T) {
+	tree := &RedBlackTree[int, int]{}
+	n := 1 << 14
+
+	for i := 0; i < n; i++ {
+		tree.Insert(i, i)
+	}
+
+	// black height of a red-black tree with n nodes is at most log2(n+1)
+	if height := checkRedBlack(t, tree); height > 15 {
+		t.Errorf("black height %d is too large for %d nodes", height, n)
+	}
+
+	depth := 0
+	var walk func(n *rbNode[int, int], d int)
+	walk = func(n *rbNode[int, int], d int) {
+		if n == nil {
+			depth = max(depth, d)
+			return
+		}
+		walk(n.left, d+1)
+		walk(n.right, d+1)
+	}
+	walk(tree.root, 0)
+	if depth > 2*15 {
+		t.Errorf("depth %d exceeds 2*log2(n+1)", depth)
+	}
+}
+
+func TestRedBlackTree_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(21))
+	tree := &RedBlackTree[int, int]{}
+	oracle := map[int]int{}
+
+	for step := 0; step < 5000; step++ {
+		key := r.Intn(300)
+
+		if r.Intn(3) == 0 {
+			_, exists := oracle[key]
+			if got := tree.Delete(key); got != exists {
+				t.Fatalf("step %d: Delete(%d) = %v, want %v", step, key, got, exists)
+			}
+			delete(oracle, key)
+		} else {
+			_, exists := oracle[key]
+			if got := tree.Insert(key, step); got == exists {
+				t.Fatalf("step %d: Insert(%d) = %v, want %v", step, key, got, !exists)
+			}
+			oracle[key] = step
+		}
+
+		checkRedBlack(t, tree)
+	}
+
+	keys := []int{}
+	for k, v := range tree.All() {
+		if oracle[k] != v {
+			t.Fatalf("value for key %d = %d, want %d", k, v, oracle[k])
+		}
+		keys = append(keys, k)
+	}
+
+	want := slices.Sorted(maps.Keys(oracle))
+	if !slices.Equal(keys, want) {
+		t.Errorf("All() keys = %v, want %v", keys, want)
+	}
+
+	backward := []int{}
+	for k := range tree.Backward() {
+		backward = append(backward, k)
+	}
+	slices.Reverse(want)
+	if !slices.Equal(backward, want) {
+		t.Errorf("Backward() keys = %v, want %v", backward, want)
+	}
+
+	// delete everything
+	for _, k := range want {
+		if !tree.Delete(k) {
+			t.Fatalf("Delete(%d) = false, want true", k)
+		}
+		checkRedBlack(t, tree)
+	}
+	if tree.Len() != 0 || tree.root != nil {
+		t.Errorf("tree should be empty, Len() = %d", tree.Len())
+	}
+}
@@ -1,6 +1,8 @@
 package tree
 
 import (
+	"iter"
+
 	"golang.org/x/exp/constraints"
 )
 
@@ -9,6 +11,22 @@ type TreeNode[T constraints.Ordered] struct {
 	left   *TreeNode[T]
 	right  *TreeNode[T]
 	parent *TreeNode[T]
+	size   int // 이 노드를 루트로 하는 서브트리의 노드 수
+}
+
+// nil 노드의 크기는 0 이다.
+func (n *TreeNode[T]) count() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// 새 노드를 붙인 뒤 부모부터 루트까지 서브트리 크기를 하나씩 늘린다.
+func (n *TreeNode[T]) growAncestors() {
+	for curr := n.parent; curr != nil; curr = curr.parent {
+		curr.size++
+	}
 }
 
 func (n *TreeNode[T]) InsertNode(value T) {
@@ -17,7 +35,9 @@ func (n *TreeNode[T]) InsertNode(value T) {
 			n.left = &TreeNode[T]{
 				value:  value,
 				parent: n,
+				size:   1,
 			}
+			n.left.growAncestors()
 		} else {
 			n.left.InsertNode(value)
 		}
@@ -26,7 +46,9 @@ func (n *TreeNode[T]) InsertNode(value T) {
 			n.right = &TreeNode[T]{
 				value:  value,
 				parent: n,
+				size:   1,
 			}
+			n.right.growAncestors()
 		} else {
 			n.right.InsertNode(value)
 		}
@@ -49,6 +71,49 @@ func (n *TreeNode[T]) FindValue(target T) (T, bool) {
 	return curr.value, true
 }
 
+func (n *TreeNode[T]) Value() T {
+	return n.value
+}
+
+func (n *TreeNode[T]) Left() *TreeNode[T] {
+	return n.left
+}
+
+func (n *TreeNode[T]) Right() *TreeNode[T] {
+	return n.right
+}
+
+// 루트이면 nil 을 반환한다.
+func (n *TreeNode[T]) Parent() *TreeNode[T] {
+	return n.parent
+}
+
+func (n *TreeNode[T]) getKey() T {
+	return n.value
+}
+
+func (n *TreeNode[T]) getLeft() *TreeNode[T] {
+	return n.left
+}
+
+func (n *TreeNode[T]) getRight() *TreeNode[T] {
+	return n.right
+}
+
+func (n *TreeNode[T]) getParent() *TreeNode[T] {
+	return n.parent
+}
+
+// 중위 순회 기준 다음 노드. 마지막 노드이면 nil 을 반환한다.
+func (n *TreeNode[T]) Successor() *TreeNode[T] {
+	return successorNode(n)
+}
+
+// 중위 순회 기준 이전 노드. 첫 노드이면 nil 을 반환한다.
+func (n *TreeNode[T]) Predecessor() *TreeNode[T] {
+	return predecessorNode(n)
+}
+
 type BinarySearchTree[T constraints.Ordered] struct {
 	root *TreeNode[T]
 }
@@ -62,10 +127,25 @@ func (bst *BinarySearchTree[T]) FindTreeNode(target T) (T, bool) {
 	return bst.root.FindValue(target)
 }
 
+// target 을 가진 노드를 반환한다. 없으면 nil 을 반환한다.
+// 반환한 노드는 RemoveTreeNode 에 넘기거나 Successor/Predecessor 로 이웃 노드를 찾는 데 쓸 수 있다.
+func (bst *BinarySearchTree[T]) Find(target T) *TreeNode[T] {
+	curr := bst.root
+	for curr != nil && curr.value != target {
+		if target > curr.value {
+			curr = curr.right
+		} else {
+			curr = curr.left
+		}
+	}
+	return curr
+}
+
 func (bst *BinarySearchTree[T]) InsertTreeNode(value T) *BinarySearchTree[T] {
 	if bst.root == nil {
 		bst.root = &TreeNode[T]{
 			value: value,
+			size:  1,
 		}
 	} else {
 		bst.root.InsertNode(value)
@@ -74,11 +154,36 @@ func (bst *BinarySearchTree[T]) InsertTreeNode(value T) *BinarySearchTree[T] {
 	return bst
 }
 
+// node 가 이 트리의 노드인지 부모를 따라 루트까지 올라가서 확인한다. O(h) 이다.
+func (bst *BinarySearchTree[T]) contains(node *TreeNode[T]) bool {
+	curr := node
+	for curr.parent != nil {
+		curr = curr.parent
+	}
+	return curr == bst.root
+}
+
+// node 를 트리에서 떼어낸다. 이 트리의 노드가 아니거나 이미 지운 노드이면 아무것도 하지 않는다.
+// 떼어낸 노드는 부모, 자식과의 연결이 끊어지므로 Successor/Predecessor 가 nil 을 반환한다.
 func (bst *BinarySearchTree[T]) RemoveTreeNode(node *TreeNode[T]) {
-	if bst.root == nil || node == nil {
+	if bst.root == nil || node == nil || !bst.contains(node) {
 		return
 	}
 
+	bst.removeNode(node)
+	node.parent = nil
+	node.left = nil
+	node.right = nil
+}
+
+func (bst *BinarySearchTree[T]) removeNode(node *TreeNode[T]) {
+	// if node 가 leaf node 또는 자식이 하나인 노드일때 node 가 빠지므로 조상들의 크기를 줄임
+	if node.left == nil || node.right == nil {
+		for curr := node.parent; curr != nil; curr = curr.parent {
+			curr.size--
+		}
+	}
+
 	// if node 가 leaf node 일때
 	if node.left == nil && node.right == nil {
 		if node.parent == nil {
@@ -120,7 +225,9 @@ func (bst *BinarySearchTree[T]) RemoveTreeNode(node *TreeNode[T]) {
 		successor = successor.left
 	}
 
-	bst.RemoveTreeNode(successor)
+	// successor 를 떼어내면서 node 와 그 조상들의 크기가 이미 하나씩 줄어든다
+	bst.removeNode(successor)
+	successor.size = node.size
 
 	if node.parent == nil {
 		bst.root = successor
@@ -141,3 +248,88 @@ func (bst *BinarySearchTree[T]) RemoveTreeNode(node *TreeNode[T]) {
 		node.right.parent = successor
 	}
 }
+
+// 가장 작은 값. 빈 트리이면 false 를 반환한다.
+func (bst *BinarySearchTree[T]) Min() (T, bool) {
+	return nodeValue(minNode(bst.root))
+}
+
+// 가장 큰 값. 빈 트리이면 false 를 반환한다.
+func (bst *BinarySearchTree[T]) Max() (T, bool) {
+	return nodeValue(maxNode(bst.root))
+}
+
+func nodeValue[T constraints.Ordered](node *TreeNode[T]) (T, bool) {
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.value, true
+}
+
+// target 이하인 가장 큰 값. 없으면 false 를 반환한다.
+func (bst *BinarySearchTree[T]) Floor(target T) (T, bool) {
+	return nodeValue(floorNode(bst.root, target, true))
+}
+
+// target 이상인 가장 작은 값. 없으면 false 를 반환한다.
+func (bst *BinarySearchTree[T]) Ceiling(target T) (T, bool) {
+	return nodeValue(ceilingNode(bst.root, target, true))
+}
+
+// target 보다 작은 가장 큰 값. 없으면 false 를 반환한다.
+func (bst *BinarySearchTree[T]) Lower(target T) (T, bool) {
+	return nodeValue(floorNode(bst.root, target, false))
+}
+
+// target 보다 큰 가장 작은 값. 없으면 false 를 반환한다.
+func (bst *BinarySearchTree[T]) Higher(target T) (T, bool) {
+	return nodeValue(ceilingNode(bst.root, target, false))
+}
+
+func (bst *BinarySearchTree[T]) Len() int {
+	return bst.root.count()
+}
+
+// target 보다 작은 값의 개수. 노드마다 서브트리 크기를 저장해 두므로 O(h) 이다.
+func (bst *BinarySearchTree[T]) Rank(target T) int {
+	return rankOf(bst.root, target)
+}
+
+// 오름차순으로 0 부터 세어 k 번째 값. k 가 범위를 벗어나면 false 를 반환한다. O(h) 이다.
+func (bst *BinarySearchTree[T]) Select(k int) (T, bool) {
+	return nodeValue(selectNode(bst.root, k))
+}
+
+// value 를 가진 노드를 하나 지운다. 없으면 false 를 반환한다.
+func (bst *BinarySearchTree[T]) Delete(value T) bool {
+	node := bst.Find(value)
+	if node == nil {
+		return false
+	}
+
+	bst.RemoveTreeNode(node)
+	return true
+}
+
+// 오름차순(중위 순회)으로 순회한다.
+func (bst *BinarySearchTree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for curr := minNode(bst.root); curr != nil; curr = curr.Successor() {
+			if !yield(curr.value) {
+				return
+			}
+		}
+	}
+}
+
+// 내림차순으로 순회한다.
+func (bst *BinarySearchTree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for curr := maxNode(bst.root); curr != nil; curr = curr.Predecessor() {
+			if !yield(curr.value) {
+				return
+			}
+		}
+	}
+}
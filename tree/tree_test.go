@@ -1,6 +1,10 @@
 package tree
 
-import "testing"
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
 
 func TestBinarySearchTree_InsertAndFind(t *testing.T) {
 	bst := &BinarySearchTree[int]{}
@@ -248,3 +252,254 @@ func TestBinarySearchTree_WithFloat64(t *testing.T) {
 		t.Error("Should not find 99.99")
 	}
 }
+
+func TestBinarySearchTree_Iterators(t *testing.T) {
+	bst := &BinarySearchTree[int]{}
+	for _, v := range []int{10, 5, 15, 3, 7, 12, 20, 6} {
+		bst.InsertTreeNode(v)
+	}
+
+	if got := slices.Collect(bst.All()); !slices.Equal(got, []int{3, 5, 6, 7, 10, 12, 15, 20}) {
+		t.Errorf("All() = %v, want in-order traversal", got)
+	}
+	if got := slices.Collect(bst.Backward()); !slices.Equal(got, []int{20, 15, 12, 10, 7, 6, 5, 3}) {
+		t.Errorf("Backward() = %v, want reverse in-order traversal", got)
+	}
+
+	empty := &BinarySearchTree[int]{}
+	if got := slices.Collect(empty.All()); len(got) != 0 {
+		t.Errorf("All() on empty tree = %v, want empty", got)
+	}
+}
+
+func TestBinarySearchTree_FindAndNavigate(t *testing.T) {
+	bst := &BinarySearchTree[int]{}
+	for _, v := range []int{10, 5, 15, 3, 7, 12, 20} {
+		bst.InsertTreeNode(v)
+	}
+
+	if bst.Find(100) != nil {
+		t.Error("Find(100) should return nil")
+	}
+
+	node := bst.Find(5)
+	if node == nil || node.Value() != 5 {
+		t.Fatalf("Find(5) = %v, want node with value 5", node)
+	}
+	if node.Left().Value() != 3 || node.Right().Value() != 7 || node.Parent().Value() != 10 {
+		t.Errorf("node 5 has left %v, right %v, parent %v, want 3, 7, 10",
+			node.Left().Value(), node.Right().Value(), node.Parent().Value())
+	}
+	if bst.Find(10).Parent() != nil {
+		t.Error("root should not have a parent")
+	}
+
+	// walk the whole tree through exported accessors only
+	got := []int{}
+	for curr := bst.Find(3); curr != nil; curr = curr.Successor() {
+		got = append(got, curr.Value())
+	}
+	if !slices.Equal(got, []int{3, 5, 7, 10, 12, 15, 20}) {
+		t.Errorf("Successor walk = %v, want ascending order", got)
+	}
+
+	got = got[:0]
+	for curr := bst.Find(20); curr != nil; curr = curr.Predecessor() {
+		got = append(got, curr.Value())
+	}
+	if !slices.Equal(got, []int{20, 15, 12, 10, 7, 5, 3}) {
+		t.Errorf("Predecessor walk = %v, want descending order", got)
+	}
+}
+
+func TestBinarySearchTree_Delete(t *testing.T) {
+	bst := &BinarySearchTree[int]{}
+	for _, v := range []int{10, 5, 15, 3, 7, 12, 20} {
+		bst.InsertTreeNode(v)
+	}
+
+	tests := []struct {
+		name  string
+		value int
+		want  bool
+		rest  []int
+	}{
+		{"Delete leaf", 3, true, []int{5, 7, 10, 12, 15, 20}},
+		{"Delete node with one child", 5, true, []int{7, 10, 12, 15, 20}},
+		{"Delete node with two children", 15, true, []int{7, 10, 12, 20}},
+		{"Delete root", 10, true, []int{7, 12, 20}},
+		{"Delete missing", 100, false, []int{7, 12, 20}},
+		{"Delete already deleted", 10, false, []int{7, 12, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bst.Delete(tt.value); got != tt.want {
+				t.Errorf("Delete(%d) = %v, want %v", tt.value, got, tt.want)
+			}
+			if got := slices.Collect(bst.All()); !slices.Equal(got, tt.rest) {
+				t.Errorf("after Delete(%d) tree = %v, want %v", tt.value, got, tt.rest)
+			}
+		})
+	}
+
+	for _, v := range []int{7, 12, 20} {
+		bst.Delete(v)
+	}
+	if bst.Find(7) != nil || len(slices.Collect(bst.All())) != 0 {
+		t.Error("tree should be empty")
+	}
+}
+
+func TestBinarySearchTree_OrderedQueries(t *testing.T) {
+	bst := &BinarySearchTree[int]{}
+
+	if _, ok := bst.Min(); ok {
+		t.Error("Min() on empty tree should return false")
+	}
+	if _, ok := bst.Floor(5); ok {
+		t.Error("Floor() on empty tree should return false")
+	}
+
+	for _, v := range []int{10, 5, 15, 3, 7, 12, 20} {
+		bst.InsertTreeNode(v)
+	}
+
+	tests := []struct {
+		name  string
+		query func(int) (int, bool)
+		arg   int
+		want  int
+		found bool
+	}{
+		{"Floor exact", bst.Floor, 7, 7, true},
+		{"Floor between", bst.Floor, 11, 10, true},
+		{"Floor below min", bst.Floor, 2, 0, false},
+		{"Ceiling exact", bst.Ceiling, 12, 12, true},
+		{"Ceiling between", bst.Ceiling, 8, 10, true},
+		{"Ceiling above max", bst.Ceiling, 21, 0, false},
+		{"Lower exact", bst.Lower, 10, 7, true},
+		{"Lower of min", bst.Lower, 3, 0, false},
+		{"Higher exact", bst.Higher, 10, 12, true},
+		{"Higher of max", bst.Higher, 20, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.query(tt.arg)
+			if found != tt.found || got != tt.want {
+				t.Errorf("query(%d) = %v, %v, want %v, %v", tt.arg, got, found, tt.want, tt.found)
+			}
+		})
+	}
+
+	if got, ok := bst.Min(); !ok || got != 3 {
+		t.Errorf("Min() = %v, %v, want 3, true", got, ok)
+	}
+	if got, ok := bst.Max(); !ok || got != 20 {
+		t.Errorf("Max() = %v, %v, want 20, true", got, ok)
+	}
+}
+
+func TestBinarySearchTree_RemoveStaleNode(t *testing.T) {
+	bst := &BinarySearchTree[int]{}
+	for _, v := range []int{10, 5, 15, 7, 12, 20} {
+		bst.InsertTreeNode(v)
+	}
+
+	n := bst.Find(5)
+	bst.RemoveTreeNode(n)
+	want := []int{7, 10, 12, 15, 20}
+	if got := slices.Collect(bst.All()); !slices.Equal(got, want) {
+		t.Fatalf("after first remove tree = %v, want %v", got, want)
+	}
+
+	// removing the same handle again must not touch the tree
+	bst.RemoveTreeNode(n)
+	if got := slices.Collect(bst.All()); !slices.Equal(got, want) {
+		t.Errorf("after second remove tree = %v, want %v", got, want)
+	}
+	if n.Parent() != nil || n.Left() != nil || n.Right() != nil {
+		t.Error("removed node should be detached from the tree")
+	}
+	if n.Successor() != nil || n.Predecessor() != nil {
+		t.Error("removed node should not walk into the live tree")
+	}
+
+	// a two-children node and the root behave the same way
+	for _, v := range []int{15, 10} {
+		node := bst.Find(v)
+		bst.RemoveTreeNode(node)
+		bst.RemoveTreeNode(node)
+	}
+	want = []int{7, 12, 20}
+	if got := slices.Collect(bst.All()); !slices.Equal(got, want) {
+		t.Errorf("tree = %v, want %v", got, want)
+	}
+
+	// nodes from another tree are ignored
+	other := &BinarySearchTree[int]{}
+	other.InsertTreeNode(12).InsertTreeNode(30)
+	bst.RemoveTreeNode(other.Find(30))
+	bst.RemoveTreeNode(other.Find(12))
+	if got := slices.Collect(bst.All()); !slices.Equal(got, want) {
+		t.Errorf("removing foreign nodes changed the tree to %v, want %v", got, want)
+	}
+	if got := slices.Collect(other.All()); !slices.Equal(got, []int{12, 30}) {
+		t.Errorf("other tree = %v, want [12 30]", got)
+	}
+}
+
+// checkSizes verifies that every node stores the size of its subtree.
+func checkSizes(t *testing.T, n *TreeNode[int]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+	size := checkSizes(t, n.left) + checkSizes(t, n.right) + 1
+	if n.size != size {
+		t.Fatalf("node %d stores size %d, want %d", n.value, n.size, size)
+	}
+	return size
+}
+
+func TestBinarySearchTree_RankSelect(t *testing.T) {
+	r := rand.New(rand.NewSource(25))
+	bst := &BinarySearchTree[int]{}
+	oracle := []int{}
+
+	for step := 0; step < 3000; step++ {
+		v := r.Intn(200)
+
+		if r.Intn(3) == 0 {
+			i, found := slices.BinarySearch(oracle, v)
+			if got := bst.Delete(v); got != found {
+				t.Fatalf("step %d: Delete(%d) = %v, want %v", step, v, got, found)
+			}
+			if found {
+				oracle = slices.Delete(oracle, i, i+1)
+			}
+		} else {
+			bst.InsertTreeNode(v)
+			i, _ := slices.BinarySearch(oracle, v)
+			oracle = slices.Insert(oracle, i, v)
+		}
+
+		checkSizes(t, bst.root)
+		if bst.Len() != len(oracle) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, bst.Len(), len(oracle))
+		}
+
+		// duplicates are allowed, so Rank counts strictly smaller values
+		query := r.Intn(210) - 5
+		if want, _ := slices.BinarySearch(oracle, query); bst.Rank(query) != want {
+			t.Fatalf("step %d: Rank(%d) = %d, want %d", step, query, bst.Rank(query), want)
+		}
+		for _, k := range []int{-1, 0, len(oracle) / 2, len(oracle) - 1, len(oracle)} {
+			got, ok := bst.Select(k)
+			if inRange := k >= 0 && k < len(oracle); ok != inRange || (ok && got != oracle[k]) {
+				t.Fatalf("step %d: Select(%d) = %d, %v", step, k, got, ok)
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package trie
 
+import "iter"
+
 type TrieNode struct {
 	is_entry bool
 	children []*TrieNode
@@ -64,6 +66,25 @@ func (t *TrieNode) Delete(value string, index int) bool {
 	return true
 }
 
+// prefix 뒤에 이어지는 단어들을 사전순으로 yield 한다.
+// 순회를 멈춰야 하면 false 를 반환한다.
+func (t *TrieNode) walk(prefix []byte, yield func(string) bool) bool {
+	if t.is_entry && !yield(string(prefix)) {
+		return false
+	}
+
+	for i, c := range t.children {
+		if c == nil {
+			continue
+		}
+		if !c.walk(append(prefix, byte('a'+i)), yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (tr *Trie) Insert(value string) {
 	tr.root.Insert(value, 0)
 }
@@ -71,3 +92,10 @@ func (tr *Trie) Insert(value string) {
 func (tr *Trie) Delete(value string) {
 	tr.root.Delete(value, 0)
 }
+
+// 저장된 단어들을 사전순으로 순회한다.
+func (tr *Trie) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		tr.root.walk(nil, yield)
+	}
+}
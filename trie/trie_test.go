@@ -0,0 +1,53 @@
+package trie
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTrie_All(t *testing.T) {
+	tr := &Trie{}
+	for _, word := range []string{"tea", "ten", "to", "a", "inn", "in", "tea"} {
+		tr.Insert(word)
+	}
+
+	want := []string{"a", "in", "inn", "tea", "ten", "to"}
+	if got := slices.Collect(tr.All()); !slices.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+
+	tr.Delete("tea")
+	tr.Delete("in")
+
+	want = []string{"a", "inn", "ten", "to"}
+	if got := slices.Collect(tr.All()); !slices.Equal(got, want) {
+		t.Errorf("All() after Delete = %v, want %v", got, want)
+	}
+}
+
+func TestTrie_AllEarlyStop(t *testing.T) {
+	tr := &Trie{}
+	for _, word := range []string{"b", "a", "c"} {
+		tr.Insert(word)
+	}
+
+	got := []string{}
+	for word := range tr.All() {
+		got = append(got, word)
+		if word == "b" {
+			break
+		}
+	}
+
+	if !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("All() with break = %v, want [a b]", got)
+	}
+}
+
+func TestTrie_AllEmpty(t *testing.T) {
+	tr := &Trie{}
+
+	if got := slices.Collect(tr.All()); len(got) != 0 {
+		t.Errorf("All() on empty trie = %v, want empty", got)
+	}
+}